@@ -2,7 +2,12 @@ package rancher2
 
 import (
 	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/rancher/norman/clientbase"
 	"github.com/rancher/norman/types"
 	clusterClient "github.com/rancher/types/client/cluster/v3"
@@ -14,61 +19,69 @@ import (
 // Client are the client kind for a Rancher v3 API
 type Client struct {
 	Management *managementClient.Client
-	Cluster    *clusterClient.Client
-	Project    *projectClient.Client
+	Cluster    map[string]*clusterClient.Client
+	Project    map[string]*projectClient.Client
 }
 
 // Config is the configuration parameters for a Rancher v3 API
 type Config struct {
-	AccessKey string `json:"accessKey"`
-	SecretKey string `json:"secretKey"`
-	TokenKey  string `json:"tokenKey"`
-	URL       string `json:"url"`
-	CACerts   string `json:"cacert"`
-	Insecure  bool   `json:"insecure"`
-	Bootstrap bool   `json:"bootstrap"`
-	ClusterID string `json:"clusterId"`
-	ProjectID string `json:"projectId"`
-	Client    Client
-}
-
-// UpdateToken update tokenkey and restart client connections
+	AccessKey        string        `json:"accessKey"`
+	SecretKey        string        `json:"secretKey"`
+	TokenKey         string        `json:"tokenKey"`
+	URL              string        `json:"url"`
+	CACerts          string        `json:"cacert"`
+	Insecure         bool          `json:"insecure"`
+	Bootstrap        bool          `json:"bootstrap"`
+	LoginTTL         time.Duration `json:"loginTtl"`
+	DefaultProjectID string        `json:"defaultProjectId"`
+	MaxRetries       int           `json:"maxRetries"`
+	RetryBackoff     time.Duration `json:"retryBackoff"`
+	DebugAPI         bool          `json:"debugApi"`
+	Client           Client
+	clientMutex      sync.Mutex
+}
+
+// UpdateToken update tokenkey and invalidate all cached client connections
 func (c *Config) UpdateToken(token string) error {
 	if len(token) == 0 {
 		return fmt.Errorf("token is nil")
 	}
 
+	c.clientMutex.Lock()
+	defer c.clientMutex.Unlock()
+
 	c.TokenKey = token
+	c.Client = Client{}
 
-	if c.Client.Management != nil {
-		c.Client.Management = nil
-	}
-	_, err := c.ManagementClient()
-	if err != nil {
-		return err
-	}
+	_, err := c.managementClient()
+	return err
+}
 
-	if c.Client.Cluster != nil {
-		c.Client.Cluster = nil
-		_, err := c.ClusterClient(c.ClusterID)
-		if err != nil {
-			return err
-		}
+// UserLogin login with username and password and use the generated session token as tokenkey
+func (c *Config) UserLogin(username, password string) error {
+	c.NormalizeURL()
 
+	tokenID, token, err := DoUserLogin(c.URL, username, password, int64(c.LoginTTL/time.Millisecond), providerDefaultSessionDesc, c.CACerts, c.Insecure)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Login with %s user: %v", username, err)
 	}
-	if c.Client.Project != nil {
-		c.Client.Project = nil
-		_, err := c.ProjectClient(c.ProjectID)
-		if err != nil {
-			return err
-		}
-	}
+	log.Printf("[INFO] Logged in with %s user, session token ID %s expires in %s", username, tokenID, c.LoginTTL)
+
+	c.TokenKey = token
 
 	return nil
 }
 
 // ManagementClient creates a Rancher client scoped to the management API
 func (c *Config) ManagementClient() (*managementClient.Client, error) {
+	c.clientMutex.Lock()
+	defer c.clientMutex.Unlock()
+
+	return c.managementClient()
+}
+
+// managementClient returns the cached management client, creating it if needed. clientMutex must be held
+func (c *Config) managementClient() (*managementClient.Client, error) {
 	if c.Client.Management != nil {
 		return c.Client.Management, nil
 	}
@@ -91,22 +104,28 @@ func (c *Config) ClusterClient(id string) (*clusterClient.Client, error) {
 		return nil, fmt.Errorf("[ERROR] Rancher Cluster Client: cluster ID is nil")
 	}
 
-	if c.Client.Cluster != nil && id == c.ClusterID {
-		return c.Client.Cluster, nil
+	c.clientMutex.Lock()
+	defer c.clientMutex.Unlock()
+
+	if cClient, ok := c.Client.Cluster[id]; ok {
+		return cClient, nil
 	}
 
 	options := c.CreateClientOpts()
 	options.URL = options.URL + "/clusters/" + id
 
-	// Setup the project client
+	// Setup the cluster client
 	cClient, err := clusterClient.NewClient(options)
 	if err != nil {
 		return nil, err
 	}
-	c.Client.Cluster = cClient
-	c.ClusterID = id
 
-	return c.Client.Cluster, nil
+	if c.Client.Cluster == nil {
+		c.Client.Cluster = map[string]*clusterClient.Client{}
+	}
+	c.Client.Cluster[id] = cClient
+
+	return cClient, nil
 }
 
 // ProjectClient creates a Rancher client scoped to a Project API
@@ -115,8 +134,11 @@ func (c *Config) ProjectClient(id string) (*projectClient.Client, error) {
 		return nil, fmt.Errorf("[ERROR] Rancher Project Client: project ID is nil")
 	}
 
-	if c.Client.Project != nil && id == c.ProjectID {
-		return c.Client.Project, nil
+	c.clientMutex.Lock()
+	defer c.clientMutex.Unlock()
+
+	if pClient, ok := c.Client.Project[id]; ok {
+		return pClient, nil
 	}
 
 	options := c.CreateClientOpts()
@@ -128,10 +150,12 @@ func (c *Config) ProjectClient(id string) (*projectClient.Client, error) {
 		return nil, err
 	}
 
-	c.Client.Project = pClient
-	c.ProjectID = id
+	if c.Client.Project == nil {
+		c.Client.Project = map[string]*projectClient.Client{}
+	}
+	c.Client.Project[id] = pClient
 
-	return c.Client.Project, nil
+	return pClient, nil
 }
 
 func (c *Config) NormalizeURL() {
@@ -141,15 +165,35 @@ func (c *Config) NormalizeURL() {
 func (c *Config) CreateClientOpts() *clientbase.ClientOpts {
 	c.NormalizeURL()
 
+	// CACerts and Insecure are set on the transport, clientbase would override it otherwise
 	options := &clientbase.ClientOpts{
 		URL:       c.URL,
 		AccessKey: c.AccessKey,
 		SecretKey: c.SecretKey,
 		TokenKey:  c.TokenKey,
-		CACerts:   c.CACerts,
-		Insecure:  c.Insecure,
 	}
 
+	httpTransport := newHTTPTransport(c.CACerts, c.Insecure)
+	// clientbase only gets the websocket TLS config from an *http.Transport, wrapped transports hide it
+	options.WSDialer = &websocket.Dialer{
+		Proxy:           httpTransport.Proxy,
+		TLSClientConfig: httpTransport.TLSClientConfig,
+	}
+
+	var transport http.RoundTripper = httpTransport
+	if c.DebugAPI {
+		transport = &debugTransport{Transport: transport}
+	}
+	retry := &retryTransport{
+		Transport:  transport,
+		MaxRetries: c.MaxRetries,
+		Backoff:    c.RetryBackoff,
+		Timeout:    retryDefaultTimeout,
+	}
+	// clientbase sets the timeout on the whole http client call, it must cover all the tries
+	options.HTTPClient = &http.Client{Transport: retry}
+	options.Timeout = retry.maxDuration()
+
 	return options
 }
 
@@ -385,6 +429,50 @@ func (c *Config) ClusterRegistrationTokenExist(id string) error {
 	return nil
 }
 
+func (c *Config) GetNotifierByID(id string) (*managementClient.Notifier, error) {
+	if id == "" {
+		return nil, fmt.Errorf("Notifier id is nil")
+	}
+
+	client, err := c.ManagementClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Notifier.ByID(id)
+}
+
+// SetAlertRecipientsType sets recipients notifier type from their notifier, if not provided
+func (c *Config) SetAlertRecipientsType(recipients []managementClient.Recipient) error {
+	for i := range recipients {
+		if len(recipients[i].NotifierType) > 0 {
+			continue
+		}
+
+		notifier, err := c.GetNotifierByID(recipients[i].NotifierID)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Getting Notifier ID %s: %s", recipients[i].NotifierID, err)
+		}
+
+		switch {
+		case notifier.PagerdutyConfig != nil:
+			recipients[i].NotifierType = alertRecipientTypePagerduty
+		case notifier.SlackConfig != nil:
+			recipients[i].NotifierType = alertRecipientTypeSlack
+		case notifier.SMTPConfig != nil:
+			recipients[i].NotifierType = alertRecipientTypeEmail
+		case notifier.WebhookConfig != nil:
+			recipients[i].NotifierType = alertRecipientTypeWebhook
+		case notifier.WechatConfig != nil:
+			recipients[i].NotifierType = alertRecipientTypeWechat
+		default:
+			return fmt.Errorf("[ERROR] Notifier ID %s has no config", recipients[i].NotifierID)
+		}
+	}
+
+	return nil
+}
+
 func (c *Config) CheckAuthConfigEnabled(id string) error {
 	if id == "" {
 		return fmt.Errorf("Auth config id is nil")
@@ -424,7 +512,12 @@ func (c *Config) GetAuthConfig(in *managementClient.AuthConfig) (interface{}, er
 	resource := types.Resource{}
 	resource.Links = in.Links
 
-	err = c.Client.Management.GetLink(resource, link, resp)
+	client, err := c.ManagementClient()
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.GetLink(resource, link, resp)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting Auth Config [%s] %s", resource.Links[link], err)
 	}
@@ -433,7 +526,12 @@ func (c *Config) GetAuthConfig(in *managementClient.AuthConfig) (interface{}, er
 }
 
 func (c *Config) UpdateAuthConfig(url string, createObj interface{}, respObject interface{}) error {
-	return c.Client.Management.Ops.DoModify("PUT", url, createObj, respObject)
+	client, err := c.ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	return client.Ops.DoModify("PUT", url, createObj, respObject)
 }
 
 func (c *Config) GetUserByName(name string) (*managementClient.User, error) {
@@ -0,0 +1,72 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+func dataSourceRancher2AppRevisions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRancher2AppRevisionsRead,
+
+		Schema: appRevisionsFields(),
+	}
+}
+
+func dataSourceRancher2AppRevisionsRead(d *schema.ResourceData, meta interface{}) error {
+	projectID := d.Get("project_id").(string)
+	appID := d.Get("app_id").(string)
+	log.Printf("[INFO] Refreshing Rancher2 App Revisions: %s", appID)
+
+	client, err := meta.(*Config).ProjectClient(projectID)
+	if err != nil {
+		return err
+	}
+
+	app, err := client.App.ByID(appID)
+	if err != nil {
+		return err
+	}
+
+	// App revisions are on the app namespace, labeled with the app name
+	namespaceID, _ := splitID(app.ID)
+	filters := map[string]interface{}{
+		"namespaceId": namespaceID,
+	}
+	listOpts := NewListOpts(filters)
+
+	collection, err := client.AppRevision.List(listOpts)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Listing revisions for App ID %s: %s", appID, err)
+	}
+
+	revisions := []projectClient.AppRevision{}
+	currentRevisionID := ""
+	for _, revision := range collection.Data {
+		if revision.Labels[appRevisionAppIDLabel] != app.Name {
+			continue
+		}
+		if revision.Name == app.AppRevisionID {
+			currentRevisionID = revision.ID
+		}
+		revisions = append(revisions, revision)
+	}
+
+	flatRevisions, err := flattenAppRevisions(revisions, app.AppRevisionID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(app.ID)
+	d.Set("current_revision_id", currentRevisionID)
+
+	err = d.Set("revisions", flatRevisions)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
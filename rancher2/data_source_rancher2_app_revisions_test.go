@@ -0,0 +1,41 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccRancher2AppRevisionsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRancher2AppConfig,
+			},
+			{
+				Config: testAccRancher2AppUpdateConfig,
+			},
+			{
+				Config: testAccRancher2AppUpdateConfig + testAccCheckRancher2AppRevisionsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rancher2_app_revisions.foo", "revisions.#", "2"),
+					resource.TestCheckResourceAttr("data.rancher2_app_revisions.foo", "revisions.0.template_version", "1.6.1"),
+					resource.TestCheckResourceAttr("data.rancher2_app_revisions.foo", "revisions.0.current", "false"),
+					resource.TestCheckResourceAttr("data.rancher2_app_revisions.foo", "revisions.1.template_version", "1.8.1"),
+					resource.TestCheckResourceAttr("data.rancher2_app_revisions.foo", "revisions.1.current", "true"),
+					resource.TestCheckResourceAttr("data.rancher2_app_revisions.foo", "revisions.1.answers.ingress_host", "test2.xip.io"),
+					resource.TestCheckResourceAttrPair("data.rancher2_app_revisions.foo", "current_revision_id", "data.rancher2_app_revisions.foo", "revisions.1.id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckRancher2AppRevisionsDataSourceConfig = `
+data "rancher2_app_revisions" "foo" {
+  project_id = "${rancher2_app.foo.project_id}"
+  app_id = "${rancher2_app.foo.id}"
+}
+`
@@ -0,0 +1,67 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func dataSourceRancher2CatalogTemplate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRancher2CatalogTemplateRead,
+
+		Schema: catalogTemplateFields(),
+	}
+}
+
+func dataSourceRancher2CatalogTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	catalogName := d.Get("catalog_name").(string)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Refreshing Rancher2 Catalog Template: %s/%s", catalogName, name)
+
+	template, err := getCatalogTemplate(client, catalogName, name, d.Get("cluster_id").(string), d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	return flattenCatalogTemplate(d, template)
+}
+
+// getCatalogTemplate returns the template name of catalogName. Catalog is global, or cluster or project scoped if clusterID or projectID are set
+func getCatalogTemplate(client *managementClient.Client, catalogName, name, clusterID, projectID string) (*managementClient.CatalogTemplate, error) {
+	// Rancher names catalog templates as <catalog_name>-<template_name>
+	filters := map[string]interface{}{
+		"name": catalogName + "-" + name,
+	}
+	switch {
+	case len(projectID) > 0:
+		_, projectName := splitID(projectID)
+		filters["projectCatalogId"] = projectName + ":" + catalogName
+	case len(clusterID) > 0:
+		filters["clusterCatalogId"] = clusterID + ":" + catalogName
+	default:
+		filters["catalogId"] = catalogName
+	}
+	listOpts := NewListOpts(filters)
+
+	templates, err := client.CatalogTemplate.List(listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	count := len(templates.Data)
+	if count <= 0 {
+		return nil, fmt.Errorf("[ERROR] catalog template %s not found on catalog %s", name, catalogName)
+	} else if count > 1 {
+		return nil, fmt.Errorf("[ERROR] found %d catalog templates %s on catalog %s", count, name, catalogName)
+	}
+
+	return &templates.Data[0], nil
+}
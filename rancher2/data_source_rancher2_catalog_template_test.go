@@ -0,0 +1,33 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccRancher2CatalogTemplateDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckRancher2CatalogTemplateDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rancher2_catalog_template.foo", "id", "cattle-global-data:library-docker-registry"),
+					resource.TestCheckResourceAttr("data.rancher2_catalog_template.foo", "name", "docker-registry"),
+					resource.TestCheckResourceAttrSet("data.rancher2_catalog_template.foo", "default_version"),
+					resource.TestCheckResourceAttrSet("data.rancher2_catalog_template.foo", "default_template_version_id"),
+					resource.TestCheckResourceAttrSet("data.rancher2_catalog_template.foo", "versions.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckRancher2CatalogTemplateDataSourceConfig = `
+data "rancher2_catalog_template" "foo" {
+  catalog_name = "library"
+  name = "docker-registry"
+}
+`
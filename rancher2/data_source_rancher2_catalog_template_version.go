@@ -0,0 +1,44 @@
+package rancher2
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceRancher2CatalogTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRancher2CatalogTemplateVersionRead,
+
+		Schema: catalogTemplateVersionFields(),
+	}
+}
+
+func dataSourceRancher2CatalogTemplateVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	catalogName := d.Get("catalog_name").(string)
+	templateName := d.Get("template_name").(string)
+	log.Printf("[INFO] Refreshing Rancher2 Catalog Template Version: %s/%s %s", catalogName, templateName, d.Get("version").(string))
+
+	template, err := getCatalogTemplate(client, catalogName, templateName, d.Get("cluster_id").(string), d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// Template versions are named <template_name>-<version>, on the template namespace
+	templateVersionID := template.DefaultTemplateVersionID
+	if version := d.Get("version").(string); len(version) > 0 {
+		templateVersionID = template.ID + "-" + version
+	}
+
+	templateVersion, err := client.TemplateVersion.ByID(templateVersionID)
+	if err != nil {
+		return err
+	}
+
+	return flattenCatalogTemplateVersion(d, templateVersion)
+}
@@ -0,0 +1,38 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccRancher2CatalogTemplateVersionDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckRancher2CatalogTemplateVersionDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rancher2_catalog_template_version.foo", "id", "cattle-global-data:library-docker-registry-1.6.1"),
+					resource.TestCheckResourceAttr("data.rancher2_catalog_template_version.foo", "version", "1.6.1"),
+					resource.TestCheckResourceAttr("data.rancher2_catalog_template_version.foo", "external_id", "catalog://?catalog=library&template=docker-registry&version=1.6.1"),
+					resource.TestCheckResourceAttrSet("data.rancher2_catalog_template_version.foo", "questions.0.variable"),
+					resource.TestCheckResourceAttrPair("data.rancher2_catalog_template_version.default", "version", "data.rancher2_catalog_template.foo", "default_version"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckRancher2CatalogTemplateVersionDataSourceConfig = testAccCheckRancher2CatalogTemplateDataSourceConfig + `
+data "rancher2_catalog_template_version" "foo" {
+  catalog_name = "library"
+  template_name = "docker-registry"
+  version = "1.6.1"
+}
+data "rancher2_catalog_template_version" "default" {
+  catalog_name = "library"
+  template_name = "docker-registry"
+}
+`
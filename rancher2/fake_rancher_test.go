@@ -0,0 +1,1022 @@
+package rancher2
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	clusterClient "github.com/rancher/types/client/cluster/v3"
+	managementClient "github.com/rancher/types/client/management/v3"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+// Fake rancher v3 API, used to run acceptance tests offline if RANCHER_URL is not set.
+// It implements norman collection, resource and action conventions for management, cluster and project schemas.
+
+const (
+	fakeRancherTokenKey    = "token-fake:fakerancher"
+	fakeRancherAdminPass   = "fakeadmin"
+	fakeRancherIDLetters   = "abcdefghijklmnopqrstuvwxyz0123456789"
+	fakeRancherStateActive = "active"
+	fakeRancherAdminID     = "user-fake"
+)
+
+var (
+	// fakeRancherInitialStates are the states objects are created with, instead of activating.
+	// They become active on first read, to exercise StateChangeConf waits
+	fakeRancherInitialStates = map[string]string{
+		managementClient.NodePoolType:        "provisioning",
+		projectClient.AppType:                "installing",
+		managementClient.MultiClusterAppType: "deploying",
+	}
+	// fakeRancherNameIDTypes are the types using name as ID
+	fakeRancherNameIDTypes = map[string]bool{
+		managementClient.CatalogType:                   true,
+		managementClient.SettingType:                   true,
+		clusterClient.NamespaceType:                    true,
+		managementClient.NodeDriverType:                true,
+		managementClient.PodSecurityPolicyTemplateType: true,
+	}
+	// fakeRancherWriteOnlyFields are the fields by type that rancher stores but blanks on read
+	fakeRancherWriteOnlyFields = map[string][]string{
+		managementClient.CatalogType:            {"password"},
+		managementClient.ClusterCatalogType:     {"password"},
+		managementClient.ProjectCatalogType:     {"password"},
+		projectClient.CertificateType:           {"key"},
+		projectClient.NamespacedCertificateType: {"key"},
+		managementClient.ClusterType:            {"monitoringInput"},
+	}
+	// fakeRancherActions are the actions available by type. Action handlers are defined on fakeRancherActionHandlers
+	fakeRancherActions = map[string][]string{
+		managementClient.AuthConfigType:       {"disable"},
+		managementClient.ClusterType:          {"generateKubeconfig", "enableMonitoring", "editMonitoring", "disableMonitoring", "viewMonitoring"},
+		managementClient.UserType:             {"setpassword"},
+		clusterClient.NamespaceType:           {"move"},
+		managementClient.NodeDriverType:       {"activate", "deactivate"},
+		projectClient.AppType:                 {"upgrade", "rollback"},
+		managementClient.MultiClusterAppType:  {"addProjects", "removeProjects", "rollback"},
+		managementClient.CatalogType:          {"refresh"},
+		managementClient.ClusterCatalogType:   {"refresh"},
+		managementClient.ProjectCatalogType:   {"refresh"},
+		managementClient.NotifierType:         {"send"},
+		managementClient.ClusterAlertRuleType: {"activate", "deactivate", "mute", "unmute"},
+		managementClient.GlobalDNSType:        {"addProjects", "removeProjects"},
+		managementClient.ProjectAlertRuleType: {"activate", "deactivate", "mute", "unmute"},
+		managementClient.ProjectType:          {"setpodsecuritypolicytemplate"},
+	}
+	// fakeRancherActionHandlers are the action handlers by type and action name, handlers returning nil and default handler return the object
+	fakeRancherActionHandlers = map[string]fakeRancherActionHandler{
+		managementClient.AuthConfigType + ".disable": func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+			obj["enabled"] = false
+			return nil, nil
+		},
+		clusterClient.NamespaceType + ".move": func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+			projectID, _ := input["projectId"].(string)
+			fakeRancherNamespaceSetProject(obj, projectID)
+			return nil, nil
+		},
+		managementClient.ClusterType + ".generateKubeconfig": func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+			return map[string]interface{}{"type": "generateKubeConfigOutput", "config": "apiVersion: v1\nkind: Config\n"}, nil
+		},
+		projectClient.AppType + ".upgrade": func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+			for _, field := range []string{"externalId", "answers", "valuesYaml"} {
+				obj[field] = input[field]
+			}
+			fakeRancherAppDeploying(obj, "upgrading")
+			return nil, nil
+		},
+		projectClient.AppType + ".rollback": func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+			revisionID, _ := input["revisionId"].(string)
+			revision, ok := f.objects["/v3/projects/"+obj["projectId"].(string)+"/apprevisions"][revisionID]
+			if !ok {
+				return nil, fmt.Errorf("app revision %s not found", revisionID)
+			}
+			status := revision["status"].(map[string]interface{})
+			for _, field := range []string{"externalId", "answers", "valuesYaml"} {
+				obj[field] = status[field]
+			}
+			fakeRancherAppDeploying(obj, "rollingback")
+			return nil, nil
+		},
+		managementClient.CatalogType + ".refresh":        fakeRancherCatalogRefresh,
+		managementClient.ClusterCatalogType + ".refresh": fakeRancherCatalogRefresh,
+		managementClient.ProjectCatalogType + ".refresh": fakeRancherCatalogRefresh,
+		managementClient.MultiClusterAppType + ".addProjects": func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+			targets, _ := obj["targets"].([]interface{})
+			projects, _ := input["projects"].([]interface{})
+			for _, project := range projects {
+				targets = append(targets, fakeRancherMultiClusterAppTarget(obj, project.(string)))
+			}
+			obj["targets"] = targets
+			if answers, ok := input["answers"].([]interface{}); ok {
+				existing, _ := obj["answers"].([]interface{})
+				obj["answers"] = append(existing, answers...)
+			}
+			obj["state"] = "updating"
+			obj["transitioning"] = "yes"
+			return nil, nil
+		},
+		managementClient.MultiClusterAppType + ".removeProjects": func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+			projects, _ := input["projects"].([]interface{})
+			removed := map[string]bool{}
+			for _, project := range projects {
+				removed[project.(string)] = true
+			}
+			targets := []interface{}{}
+			existing, _ := obj["targets"].([]interface{})
+			for _, target := range existing {
+				if !removed[target.(map[string]interface{})["projectId"].(string)] {
+					targets = append(targets, target)
+				}
+			}
+			obj["targets"] = targets
+			answers := []interface{}{}
+			existing, _ = obj["answers"].([]interface{})
+			for _, answer := range existing {
+				if projectID, _ := answer.(map[string]interface{})["projectId"].(string); !removed[projectID] {
+					answers = append(answers, answer)
+				}
+			}
+			obj["answers"] = answers
+			obj["state"] = "updating"
+			obj["transitioning"] = "yes"
+			return nil, nil
+		},
+		managementClient.GlobalDNSType + ".addProjects": func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+			projectIDs, _ := obj["projectIds"].([]interface{})
+			added, _ := input["projectIds"].([]interface{})
+			obj["projectIds"] = append(projectIDs, added...)
+			return nil, nil
+		},
+		managementClient.GlobalDNSType + ".removeProjects": func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+			removed := map[string]bool{}
+			if projectIDs, ok := input["projectIds"].([]interface{}); ok {
+				for _, projectID := range projectIDs {
+					removed[projectID.(string)] = true
+				}
+			}
+			projectIDs := []interface{}{}
+			existing, _ := obj["projectIds"].([]interface{})
+			for _, projectID := range existing {
+				if !removed[projectID.(string)] {
+					projectIDs = append(projectIDs, projectID)
+				}
+			}
+			obj["projectIds"] = projectIDs
+			return nil, nil
+		},
+		managementClient.ProjectType + ".setpodsecuritypolicytemplate": func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+			obj["podSecurityPolicyTemplateId"] = input["podSecurityPolicyTemplateId"]
+			return nil, nil
+		},
+		managementClient.ClusterType + ".enableMonitoring":  fakeRancherClusterMonitoring(true),
+		managementClient.ClusterType + ".editMonitoring":    fakeRancherClusterMonitoring(true),
+		managementClient.ClusterType + ".disableMonitoring": fakeRancherClusterMonitoring(false),
+		managementClient.ClusterType + ".viewMonitoring": func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+			output := map[string]interface{}{"type": "monitoringOutput"}
+			if monitoringInput, ok := obj["monitoringInput"].(map[string]interface{}); ok {
+				output["answers"] = monitoringInput["answers"]
+				output["version"] = monitoringInput["version"]
+			}
+			return output, nil
+		},
+		managementClient.ClusterAlertRuleType + ".activate":   fakeRancherAlertRuleAction(alertRuleStateActive),
+		managementClient.ClusterAlertRuleType + ".deactivate": fakeRancherAlertRuleAction(alertRuleStateInactive),
+		managementClient.ClusterAlertRuleType + ".mute":       fakeRancherAlertRuleAction(alertRuleStateMuted),
+		managementClient.ClusterAlertRuleType + ".unmute":     fakeRancherAlertRuleAction(alertRuleStateActive),
+		managementClient.ProjectAlertRuleType + ".activate":   fakeRancherAlertRuleAction(alertRuleStateActive),
+		managementClient.ProjectAlertRuleType + ".deactivate": fakeRancherAlertRuleAction(alertRuleStateInactive),
+		managementClient.ProjectAlertRuleType + ".mute":       fakeRancherAlertRuleAction(alertRuleStateMuted),
+		managementClient.ProjectAlertRuleType + ".unmute":     fakeRancherAlertRuleAction(alertRuleStateActive),
+	}
+	// fakeRancherTransitionHooks are called when objects finish transitioning by type
+	fakeRancherTransitionHooks = map[string]func(f *fakeRancher, obj map[string]interface{}){
+		projectClient.AppType:               fakeRancherAppDeployed,
+		managementClient.CatalogType:        fakeRancherCatalogRefreshed,
+		managementClient.ClusterCatalogType: fakeRancherCatalogRefreshed,
+		managementClient.ClusterType:        fakeRancherClusterMonitoringDeployed,
+		managementClient.ProjectCatalogType: fakeRancherCatalogRefreshed,
+	}
+	// fakeRancherCreateHooks are called before storing new objects by type
+	fakeRancherCreateHooks = map[string]func(f *fakeRancher, obj map[string]interface{}){
+		clusterClient.NamespaceType: func(f *fakeRancher, obj map[string]interface{}) {
+			projectID, _ := obj["projectId"].(string)
+			fakeRancherNamespaceSetProject(obj, projectID)
+		},
+		managementClient.ClusterType: func(f *fakeRancher, obj map[string]interface{}) {
+			// Clusters without nodes don't get active
+			switch obj["driver"] {
+			case clusterDriverRKE:
+				obj["state"] = "provisioning"
+				obj["transitioning"] = "no"
+			case clusterDriverImported:
+				// Driver is set once the cluster agent is registered
+				delete(obj, "driver")
+				obj["state"] = "pending"
+				obj["transitioning"] = "no"
+			}
+		},
+		managementClient.NodeDriverType: func(f *fakeRancher, obj map[string]interface{}) {
+			annotations, _ := obj["annotations"].(map[string]interface{})
+			if annotations == nil {
+				annotations = map[string]interface{}{}
+			}
+			annotations["lifecycle.cattle.io/create.node-driver-controller"] = "true"
+			obj["annotations"] = annotations
+		},
+		managementClient.ClusterRegistrationTokenType: func(f *fakeRancher, obj map[string]interface{}) {
+			obj["id"] = obj["clusterId"].(string) + ":" + obj["name"].(string)
+			obj["command"] = "kubectl apply -f " + f.Server.URL + "/v3/import/" + obj["name"].(string) + ".yaml"
+		},
+		projectClient.AppType: func(f *fakeRancher, obj map[string]interface{}) {
+			fakeRancherNewAppRevision(f, obj)
+		},
+		managementClient.UserType: func(f *fakeRancher, obj map[string]interface{}) {
+			// Rancher adds local user principal
+			principalIDs, _ := obj["principalIds"].([]interface{})
+			local := "local://" + obj["id"].(string)
+			for _, principalID := range principalIDs {
+				if principalID == local {
+					return
+				}
+			}
+			obj["principalIds"] = append([]interface{}{local}, principalIDs...)
+		},
+		managementClient.MultiClusterAppType: func(f *fakeRancher, obj map[string]interface{}) {
+			obj["id"] = multiClusterAppTemplateVersionIDPrefix + obj["name"].(string)
+			targets, _ := obj["targets"].([]interface{})
+			for i, target := range targets {
+				targets[i] = fakeRancherMultiClusterAppTarget(obj, target.(map[string]interface{})["projectId"].(string))
+			}
+			if roles, _ := obj["roles"].([]interface{}); len(roles) == 0 {
+				obj["roles"] = []interface{}{"project-member"}
+			}
+			obj["status"] = map[string]interface{}{"revisionId": "mcapprevision-" + fakeRancherRandomID(5)}
+		},
+		managementClient.ClusterCatalogType: func(f *fakeRancher, obj map[string]interface{}) {
+			obj["id"] = obj["clusterId"].(string) + ":" + obj["name"].(string)
+		},
+		managementClient.ProjectCatalogType: func(f *fakeRancher, obj map[string]interface{}) {
+			projectID := obj["projectId"].(string)
+			obj["id"] = projectID[strings.Index(projectID, ":")+1:] + ":" + obj["name"].(string)
+		},
+		managementClient.ClusterAlertRuleType: func(f *fakeRancher, obj map[string]interface{}) {
+			obj["alertState"] = alertRuleStateActive
+		},
+		managementClient.ProjectAlertRuleType: func(f *fakeRancher, obj map[string]interface{}) {
+			obj["alertState"] = alertRuleStateActive
+		},
+		managementClient.TokenType: func(f *fakeRancher, obj map[string]interface{}) {
+			obj["name"] = obj["id"]
+			obj["token"] = obj["id"].(string) + ":" + fakeRancherRandomID(20)
+			obj["enabled"] = true
+			obj["expired"] = false
+		},
+	}
+)
+
+// fakeRancherNamespaceSetProject sets namespace projectId and its project annotation, removing them if projectID is empty
+func fakeRancherNamespaceSetProject(obj map[string]interface{}, projectID string) {
+	annotations, _ := obj["annotations"].(map[string]interface{})
+	if annotations == nil {
+		annotations = map[string]interface{}{}
+	}
+	if projectID == "" {
+		delete(obj, "projectId")
+		delete(annotations, namespaceProjectIDAnnotation)
+	} else {
+		obj["projectId"] = projectID
+		annotations[namespaceProjectIDAnnotation] = projectID
+	}
+	obj["annotations"] = annotations
+}
+
+// fakeRancherAlertRuleAction returns an action handler setting alert rule alertState
+func fakeRancherAlertRuleAction(alertState string) fakeRancherActionHandler {
+	return func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+		obj["alertState"] = alertState
+		return nil, nil
+	}
+}
+
+// fakeRancherClusterMonitoring enables or disables cluster monitoring. Monitoring components are deployed on active clusters until next read
+func fakeRancherClusterMonitoring(enable bool) fakeRancherActionHandler {
+	return func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+		obj["enableClusterMonitoring"] = enable
+		if !enable {
+			delete(obj, "monitoringInput")
+			delete(obj, "monitoringStatus")
+			return nil, nil
+		}
+		obj["monitoringInput"] = input
+		if obj["state"] == fakeRancherStateActive {
+			obj["transitioning"] = "yes"
+		}
+		return nil, nil
+	}
+}
+
+// fakeRancherClusterMonitoringDeployed deploys monitoring components of an active cluster. Like norman, condition
+// lastUpdateTime only changes with condition status, so redeploying already deployed components doesn't update it
+func fakeRancherClusterMonitoringDeployed(f *fakeRancher, obj map[string]interface{}) {
+	if obj["enableClusterMonitoring"] != true || obj["monitoringStatus"] != nil {
+		return
+	}
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	obj["monitoringStatus"] = map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "PrometheusDeployed", "status": "True", "lastUpdateTime": now},
+			map[string]interface{}{"type": "MetricExpressionDeployed", "status": "True", "lastUpdateTime": now},
+		},
+	}
+}
+
+// fakeRancherCatalogRefresh sets catalog as refreshing until next read
+func fakeRancherCatalogRefresh(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error) {
+	obj["transitioning"] = "yes"
+	obj["conditions"] = []interface{}{
+		map[string]interface{}{"type": catalogConditionRefreshed, "status": "Unknown"},
+	}
+	return nil, nil
+}
+
+// fakeRancherCatalogRefreshed finishes a catalog refresh started by fakeRancherCatalogRefresh
+func fakeRancherCatalogRefreshed(f *fakeRancher, obj map[string]interface{}) {
+	obj["lastRefreshTimestamp"] = time.Now().UTC().Format(time.RFC3339Nano)
+	obj["conditions"] = []interface{}{
+		map[string]interface{}{"type": catalogConditionRefreshed, "status": "True"},
+	}
+}
+
+type fakeRancherActionHandler func(f *fakeRancher, obj, input map[string]interface{}) (interface{}, error)
+
+// fakeRancherAPI is a rancher v3 API scope, management, cluster or project
+type fakeRancherAPI struct {
+	// collections are the collection names by type
+	collections map[string]string
+	// types are the types by collection names
+	types map[string]string
+}
+
+type fakeRancher struct {
+	sync.Mutex
+	Server *httptest.Server
+	apis   map[string]*fakeRancherAPI
+	// objects are stored by scope and collection URL path, and ID
+	objects map[string]map[string]map[string]interface{}
+}
+
+// newFakeRancher starts a fake rancher v3 API with a local active cluster
+func newFakeRancher() *fakeRancher {
+	f := &fakeRancher{
+		apis: map[string]*fakeRancherAPI{
+			"management": newFakeRancherAPI(managementClient.Client{}),
+			"cluster":    newFakeRancherAPI(clusterClient.Client{}),
+			"project":    newFakeRancherAPI(projectClient.Client{}),
+		},
+		objects: map[string]map[string]map[string]interface{}{},
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.ServeHTTP))
+
+	f.store("/v3/clusters", map[string]interface{}{
+		"id":          testAccRancher2DefaultClusterID,
+		"type":        managementClient.ClusterType,
+		"name":        testAccRancher2DefaultClusterID,
+		"driver":      "imported",
+		"internal":    true,
+		"state":       fakeRancherStateActive,
+		"annotations": map[string]interface{}{},
+		"labels":      map[string]interface{}{},
+	})
+	f.store("/v3/users", map[string]interface{}{
+		"id":       fakeRancherAdminID,
+		"type":     managementClient.UserType,
+		"username": "admin",
+		"name":     "Default Admin",
+		"enabled":  true,
+		"state":    fakeRancherStateActive,
+	})
+	settings := map[string]string{
+		"server-image":  "rancher/rancher",
+		"server-url":    f.Server.URL,
+		"telemetry-opt": "prompt",
+	}
+	for id, value := range settings {
+		f.store("/v3/settings", map[string]interface{}{
+			"id":    id,
+			"type":  managementClient.SettingType,
+			"name":  id,
+			"value": value,
+		})
+	}
+	for _, id := range []string{"amazonec2", "azure", "digitalocean", "openstack", "vmwarevsphere"} {
+		f.store("/v3/nodedrivers", map[string]interface{}{
+			"id":      id,
+			"type":    managementClient.NodeDriverType,
+			"name":    id,
+			"builtin": true,
+			"active":  true,
+			"state":   fakeRancherStateActive,
+		})
+	}
+	authConfigTypes := map[string]string{
+		AuthConfigActiveDirectoryName: managementClient.ActiveDirectoryConfigType,
+		AuthConfigADFSName:            managementClient.ADFSConfigType,
+		AuthConfigAzureADName:         managementClient.AzureADConfigType,
+		AuthConfigFreeIpaName:         managementClient.FreeIpaConfigType,
+		AuthConfigGithubName:          managementClient.GithubConfigType,
+		AuthConfigOpenLdapName:        managementClient.OpenLdapConfigType,
+		AuthConfigPingName:            managementClient.PingConfigType,
+		"local":                       managementClient.LocalConfigType,
+	}
+	for id, authType := range authConfigTypes {
+		f.store("/v3/authconfigs", map[string]interface{}{
+			"id":      id,
+			"type":    authType,
+			"name":    id,
+			"enabled": id == "local",
+		})
+	}
+	for _, id := range []string{"admin", "user", "user-base"} {
+		f.store("/v3/globalroles", map[string]interface{}{
+			"id":      id,
+			"type":    managementClient.GlobalRoleType,
+			"name":    id,
+			"builtin": true,
+			"state":   fakeRancherStateActive,
+		})
+	}
+	for _, id := range []string{"cluster-owner", "cluster-member", "project-owner", "project-member", "read-only"} {
+		context := roleTemplateContextProject
+		if strings.HasPrefix(id, roleTemplateContextCluster) {
+			context = roleTemplateContextCluster
+		}
+		f.store("/v3/roletemplates", map[string]interface{}{
+			"id":      id,
+			"type":    managementClient.RoleTemplateType,
+			"name":    id,
+			"context": context,
+			"builtin": true,
+			"state":   fakeRancherStateActive,
+		})
+	}
+	// Library catalog templates, by name and versions
+	templates := map[string][]string{
+		"docker-registry": {"1.6.1", "1.8.1"},
+	}
+	questions := []interface{}{
+		map[string]interface{}{
+			"variable": "ingress_host",
+			"label":    "Ingress host",
+			"type":     "hostname",
+			"default":  "xip.io",
+			"required": true,
+			"subquestions": []interface{}{
+				map[string]interface{}{
+					"variable": "ingress_path",
+					"label":    "Ingress path",
+					"type":     "string",
+					"default":  "/",
+				},
+			},
+		},
+	}
+	for name, versions := range templates {
+		templateID := multiClusterAppTemplateVersionIDPrefix + "library-" + name
+		specs := []interface{}{}
+		versionLinks := map[string]interface{}{}
+		for _, version := range versions {
+			versionID := templateID + "-" + version
+			versionLinks[version] = f.Server.URL + "/v3/templateversions/" + versionID
+			specs = append(specs, map[string]interface{}{
+				"version":    version,
+				"externalId": buildAppExternalID("library", name, version),
+			})
+			f.store("/v3/templateversions", map[string]interface{}{
+				"id":         versionID,
+				"type":       managementClient.TemplateVersionType,
+				"name":       "library-" + name + "-" + version,
+				"version":    version,
+				"externalId": buildAppExternalID("library", name, version),
+				"questions":  questions,
+				"state":      fakeRancherStateActive,
+			})
+		}
+		f.store("/v3/catalogtemplates", map[string]interface{}{
+			"id":                       templateID,
+			"type":                     managementClient.CatalogTemplateType,
+			"name":                     "library-" + name,
+			"catalogId":                "library",
+			"categories":               []interface{}{"Registry"},
+			"defaultVersion":           versions[len(versions)-1],
+			"defaultTemplateVersionId": templateID + "-" + versions[len(versions)-1],
+			"versionLinks":             versionLinks,
+			"versions":                 specs,
+			"state":                    fakeRancherStateActive,
+		})
+	}
+
+	return f
+}
+
+// newFakeRancherAPI gets the API types from a rancher client struct fields
+func newFakeRancherAPI(client interface{}) *fakeRancherAPI {
+	api := &fakeRancherAPI{
+		collections: map[string]string{},
+		types:       map[string]string{},
+	}
+
+	t := reflect.TypeOf(client)
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Name
+		if name == "APIBaseClient" {
+			continue
+		}
+		schemaType := fakeRancherSchemaType(name)
+		collection := strings.ToLower(schemaType) + "s"
+		api.collections[schemaType] = collection
+		api.types[collection] = schemaType
+	}
+
+	return api
+}
+
+// fakeRancherSchemaType returns the schema type for a client field name, lowering acronyms like GlobalDNSProvider to globalDnsProvider
+func fakeRancherSchemaType(name string) string {
+	in := []rune(name)
+	out := make([]rune, len(in))
+	for i, r := range in {
+		out[i] = r
+		if !unicode.IsUpper(r) {
+			continue
+		}
+		first := i == 0 || !unicode.IsUpper(in[i-1])
+		lastBeforeLower := i > 0 && i+1 < len(in) && unicode.IsLower(in[i+1])
+		if i == 0 || (!first && !lastBeforeLower) {
+			out[i] = unicode.ToLower(r)
+		}
+	}
+	return string(out)
+}
+
+func (f *fakeRancher) URL() string {
+	return f.Server.URL + "/v3"
+}
+
+func (f *fakeRancher) Close() {
+	f.Server.Close()
+}
+
+func (f *fakeRancher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	if r.URL.Path == "/v3-public/localProviders/local" && r.URL.Query().Get("action") == "login" {
+		input, err := f.readJSON(r)
+		if err != nil {
+			f.writeError(w, http.StatusUnprocessableEntity, "InvalidBodyContent", err.Error())
+			return
+		}
+		if _, ok := input["ttl"].(float64); !ok {
+			f.writeError(w, http.StatusUnprocessableEntity, "InvalidFormat", "ttl must be a number")
+			return
+		}
+		id := "token-" + fakeRancherRandomID(5)
+		f.writeJSON(w, http.StatusCreated, map[string]interface{}{"id": id, "type": "token", "token": id + ":" + fakeRancherRandomID(20)})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) == 0 || parts[0] != "v3" {
+		f.writeError(w, http.StatusNotFound, "NotFound", "path "+r.URL.Path+" not found")
+		return
+	}
+	parts = parts[1:]
+
+	// Management API root
+	if len(parts) == 0 {
+		w.Header().Set("X-API-Schemas", f.Server.URL+"/v3/schemas")
+		f.writeJSON(w, http.StatusOK, map[string]interface{}{"type": "apiRoot", "links": map[string]string{"self": f.URL()}})
+		return
+	}
+
+	// Cluster and project APIs are under their management resource URL
+	scope, apiName := "/v3", "management"
+	if len(parts) >= 3 && (parts[0] == "clusters" || parts[0] == "projects") {
+		name := strings.TrimSuffix(parts[0], "s")
+		if _, ok := f.apis[name].types[parts[2]]; ok || parts[2] == "schemas" {
+			scope, apiName = "/v3/"+parts[0]+"/"+parts[1], name
+			if f.objects["/v3/"+parts[0]][parts[1]] == nil {
+				f.writeError(w, http.StatusNotFound, "NotFound", name+" "+parts[1]+" not found")
+				return
+			}
+			parts = parts[2:]
+		}
+	}
+	api := f.apis[apiName]
+
+	if parts[0] == "schemas" {
+		f.writeSchemas(w, scope, api)
+		return
+	}
+
+	schemaType, ok := api.types[parts[0]]
+	if !ok {
+		f.writeError(w, http.StatusNotFound, "NotFound", "collection "+parts[0]+" not found")
+		return
+	}
+	collectionURL := scope + "/" + parts[0]
+
+	if len(parts) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			f.list(w, r, schemaType, collectionURL)
+		case http.MethodPost:
+			f.create(w, r, schemaType, scope, collectionURL)
+		default:
+			f.writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method+" not allowed")
+		}
+		return
+	}
+
+	id := strings.Join(parts[1:], "/")
+	obj, ok := f.objects[collectionURL][id]
+	if !ok {
+		f.writeError(w, http.StatusNotFound, "NotFound", schemaType+" "+id+" not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if obj["transitioning"] == "yes" {
+			obj["state"] = fakeRancherStateActive
+			obj["transitioning"] = "no"
+			if hook, ok := fakeRancherTransitionHooks[schemaType]; ok {
+				hook(f, obj)
+			}
+		}
+		if len(parts) == 2 && (parts[0] == "clusters" || parts[0] == "projects") && scope == "/v3" {
+			w.Header().Set("X-API-Schemas", f.Server.URL+collectionURL+"/"+id+"/schemas")
+		}
+		f.writeJSON(w, http.StatusOK, f.resource(schemaType, collectionURL, obj))
+	case http.MethodPut:
+		input, err := f.readJSON(r)
+		if err != nil {
+			f.writeError(w, http.StatusUnprocessableEntity, "InvalidBodyContent", err.Error())
+			return
+		}
+		system := fakeRancherSystemMetadata(obj)
+		for k, v := range input {
+			if k == "id" || k == "type" || k == "links" || k == "actions" {
+				continue
+			}
+			obj[k] = v
+		}
+		// Keeping labels and annotations set by rancher controllers
+		for field, values := range system {
+			metadata, ok := obj[field].(map[string]interface{})
+			if !ok {
+				metadata = map[string]interface{}{}
+				obj[field] = metadata
+			}
+			for k, v := range values {
+				if _, ok := metadata[k]; !ok {
+					metadata[k] = v
+				}
+			}
+		}
+		f.writeJSON(w, http.StatusOK, f.resource(schemaType, collectionURL, obj))
+	case http.MethodPost:
+		f.action(w, r, schemaType, collectionURL, obj)
+	case http.MethodDelete:
+		delete(f.objects[collectionURL], id)
+		obj["state"] = "removing"
+		f.writeJSON(w, http.StatusOK, f.resource(schemaType, collectionURL, obj))
+	default:
+		f.writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method+" not allowed")
+	}
+}
+
+func (f *fakeRancher) list(w http.ResponseWriter, r *http.Request, schemaType, collectionURL string) {
+	ids := []string{}
+	for id := range f.objects[collectionURL] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	data := []interface{}{}
+	for _, id := range ids {
+		obj := f.objects[collectionURL][id]
+		if fakeRancherMatchFilters(obj, r.URL.Query()) {
+			data = append(data, f.resource(schemaType, collectionURL, obj))
+		}
+	}
+
+	f.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"type":         "collection",
+		"resourceType": schemaType,
+		"links":        map[string]string{"self": f.Server.URL + collectionURL},
+		"pagination":   map[string]interface{}{"partial": false},
+		"data":         data,
+	})
+}
+
+func (f *fakeRancher) create(w http.ResponseWriter, r *http.Request, schemaType, scope, collectionURL string) {
+	obj, err := f.readJSON(r)
+	if err != nil {
+		f.writeError(w, http.StatusUnprocessableEntity, "InvalidBodyContent", err.Error())
+		return
+	}
+
+	delete(obj, "links")
+	delete(obj, "actions")
+	obj["type"] = schemaType
+	if id, _ := obj["id"].(string); id == "" {
+		obj["id"] = fakeRancherNewID(schemaType, scope, obj)
+	}
+	obj["state"] = "activating"
+	if state, ok := fakeRancherInitialStates[schemaType]; ok {
+		obj["state"] = state
+	}
+	obj["transitioning"] = "yes"
+	obj["creatorId"] = fakeRancherAdminID
+	labels, _ := obj["labels"].(map[string]interface{})
+	if labels == nil {
+		labels = map[string]interface{}{}
+	}
+	labels["cattle.io/creator"] = "norman"
+	obj["labels"] = labels
+	if hook, ok := fakeRancherCreateHooks[schemaType]; ok {
+		hook(f, obj)
+	}
+
+	if _, ok := f.objects[collectionURL][obj["id"].(string)]; ok {
+		f.writeError(w, http.StatusConflict, "AlreadyExists", schemaType+" "+obj["id"].(string)+" already exists")
+		return
+	}
+
+	f.store(collectionURL, obj)
+	f.writeJSON(w, http.StatusCreated, f.resource(schemaType, collectionURL, obj))
+}
+
+func (f *fakeRancher) action(w http.ResponseWriter, r *http.Request, schemaType, collectionURL string, obj map[string]interface{}) {
+	name := r.URL.Query().Get("action")
+	if !fakeRancherHasAction(schemaType, name) {
+		f.writeError(w, http.StatusNotFound, "NotFound", "action "+name+" not found on "+schemaType)
+		return
+	}
+
+	input, err := f.readJSON(r)
+	if err != nil {
+		f.writeError(w, http.StatusUnprocessableEntity, "InvalidBodyContent", err.Error())
+		return
+	}
+
+	handler, ok := fakeRancherActionHandlers[schemaType+"."+name]
+	if !ok {
+		f.writeJSON(w, http.StatusOK, f.resource(schemaType, collectionURL, obj))
+		return
+	}
+
+	resp, err := handler(f, obj, input)
+	if err != nil {
+		f.writeError(w, http.StatusUnprocessableEntity, "ActionError", err.Error())
+		return
+	}
+	if resp == nil {
+		resp = f.resource(schemaType, collectionURL, obj)
+	}
+	f.writeJSON(w, http.StatusOK, resp)
+}
+
+// store saves obj on collectionURL, it must have id
+func (f *fakeRancher) store(collectionURL string, obj map[string]interface{}) {
+	if f.objects[collectionURL] == nil {
+		f.objects[collectionURL] = map[string]map[string]interface{}{}
+	}
+	f.objects[collectionURL][obj["id"].(string)] = obj
+}
+
+// resource returns a copy of obj with norman links and actions
+func (f *fakeRancher) resource(schemaType, collectionURL string, obj map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	for k, v := range obj {
+		out[k] = v
+	}
+	for _, field := range fakeRancherWriteOnlyFields[schemaType] {
+		delete(out, field)
+	}
+
+	self := f.Server.URL + collectionURL + "/" + obj["id"].(string)
+	out["links"] = map[string]string{
+		"self":   self,
+		"update": self,
+		"remove": self,
+	}
+	actions := map[string]string{}
+	for _, name := range fakeRancherActions[schemaType] {
+		actions[name] = self + "?action=" + name
+	}
+	out["actions"] = actions
+
+	return out
+}
+
+func (f *fakeRancher) writeSchemas(w http.ResponseWriter, scope string, api *fakeRancherAPI) {
+	data := []interface{}{}
+	for schemaType, collection := range api.collections {
+		data = append(data, map[string]interface{}{
+			"id":                schemaType,
+			"type":              "schema",
+			"pluralName":        collection,
+			"collectionMethods": []string{http.MethodGet, http.MethodPost},
+			"resourceMethods":   []string{http.MethodGet, http.MethodPut, http.MethodDelete},
+			"links": map[string]string{
+				"self":       f.Server.URL + scope + "/schemas/" + schemaType,
+				"collection": f.Server.URL + scope + "/" + collection,
+			},
+		})
+	}
+
+	f.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"type":         "collection",
+		"resourceType": "schema",
+		"data":         data,
+	})
+}
+
+func (f *fakeRancher) readJSON(r *http.Request) (map[string]interface{}, error) {
+	obj := map[string]interface{}{}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil || len(body) == 0 {
+		return obj, err
+	}
+	err = json.Unmarshal(body, &obj)
+	return obj, err
+}
+
+func (f *fakeRancher) writeJSON(w http.ResponseWriter, status int, obj interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(obj)
+}
+
+func (f *fakeRancher) writeError(w http.ResponseWriter, status int, code, message string) {
+	f.writeJSON(w, status, map[string]interface{}{
+		"type":    "error",
+		"status":  status,
+		"code":    code,
+		"message": message,
+	})
+}
+
+// fakeRancherSystemMetadata returns obj labels and annotations with cattle.io keys
+func fakeRancherSystemMetadata(obj map[string]interface{}) map[string]map[string]interface{} {
+	out := map[string]map[string]interface{}{}
+	for _, field := range []string{"labels", "annotations"} {
+		out[field] = map[string]interface{}{}
+		metadata, _ := obj[field].(map[string]interface{})
+		for k, v := range metadata {
+			if strings.Contains(k, "cattle.io/") {
+				out[field][k] = v
+			}
+		}
+	}
+	return out
+}
+
+// fakeRancherNewAppRevision stores a revision of app current template and values, and sets it as app revision
+func fakeRancherNewAppRevision(f *fakeRancher, app map[string]interface{}) {
+	namespaceID := app["id"].(string)[:strings.Index(app["id"].(string), ":")]
+	name := "apprevision-" + fakeRancherRandomID(5)
+	f.store("/v3/projects/"+app["projectId"].(string)+"/apprevisions", map[string]interface{}{
+		"id":          namespaceID + ":" + name,
+		"type":        projectClient.AppRevisionType,
+		"name":        name,
+		"namespaceId": namespaceID,
+		"created":     time.Now().UTC().Format(time.RFC3339Nano),
+		"labels":      map[string]interface{}{appRevisionAppIDLabel: app["name"]},
+		"state":       fakeRancherStateActive,
+		"status": map[string]interface{}{
+			"externalId": app["externalId"],
+			"answers":    app["answers"],
+			"valuesYaml": app["valuesYaml"],
+			"projectId":  app["projectId"],
+		},
+	})
+	app["appRevisionId"] = name
+}
+
+// fakeRancherAppDeploying sets app on state until next read, keeping its current revision
+func fakeRancherAppDeploying(app map[string]interface{}, state string) {
+	app["state"] = state
+	app["transitioning"] = "yes"
+	app["conditions"] = []interface{}{
+		map[string]interface{}{"type": appConditionDeployed, "status": "Unknown"},
+	}
+}
+
+// fakeRancherAppDeployed finishes an app deploy started by fakeRancherAppDeploying on a new revision
+func fakeRancherAppDeployed(f *fakeRancher, app map[string]interface{}) {
+	conditions, _ := app["conditions"].([]interface{})
+	if len(conditions) == 0 {
+		return
+	}
+	fakeRancherNewAppRevision(f, app)
+	app["conditions"] = []interface{}{
+		map[string]interface{}{"type": appConditionDeployed, "status": "True"},
+	}
+}
+
+// fakeRancherMultiClusterAppTarget returns a multi cluster app target on projectID, with its deployed app
+func fakeRancherMultiClusterAppTarget(mca map[string]interface{}, projectID string) map[string]interface{} {
+	return map[string]interface{}{
+		"projectId":   projectID,
+		"appId":       projectID[strings.Index(projectID, ":")+1:] + ":mcapp-" + mca["name"].(string),
+		"state":       fakeRancherStateActive,
+		"healthState": "healthy",
+	}
+}
+
+func fakeRancherHasAction(schemaType, name string) bool {
+	for _, action := range fakeRancherActions[schemaType] {
+		if action == name {
+			return true
+		}
+	}
+	return false
+}
+
+// fakeRancherMatchFilters returns true if obj fields match all query filters, ignoring case on field names
+func fakeRancherMatchFilters(obj map[string]interface{}, query map[string][]string) bool {
+	for key, values := range query {
+		switch key {
+		case "limit", "marker", "sort", "order", "reverse":
+			continue
+		}
+		found := false
+		for field, value := range obj {
+			if strings.EqualFold(field, key) {
+				found = fmt.Sprintf("%v", value) == values[0]
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// fakeRancherNewID generates object IDs like rancher does, prefixed by cluster or project if scoped
+func fakeRancherNewID(schemaType, scope string, obj map[string]interface{}) string {
+	name, _ := obj["name"].(string)
+	clusterID, _ := obj["clusterId"].(string)
+	projectID, _ := obj["projectId"].(string)
+	namespaceID, _ := obj["namespaceId"].(string)
+
+	switch {
+	case schemaType == managementClient.ProjectType:
+		return clusterID + ":p-" + fakeRancherRandomID(5)
+	case fakeRancherNameIDTypes[schemaType] && name != "":
+		return name
+	case namespaceID != "" && name != "":
+		return namespaceID + ":" + name
+	case strings.HasPrefix(scope, "/v3/projects/") && name != "":
+		return scope[strings.LastIndex(scope, ":")+1:] + ":" + name
+	}
+
+	// Prefix is the type initials, like grb for globalRoleBinding
+	prefix := string(schemaType[0])
+	for _, c := range schemaType[1:] {
+		if unicode.IsUpper(c) {
+			prefix += string(unicode.ToLower(c))
+		}
+	}
+	id := prefix + "-" + fakeRancherRandomID(5)
+
+	switch {
+	case projectID != "":
+		return projectID[strings.Index(projectID, ":")+1:] + ":" + id
+	case clusterID != "" && schemaType != managementClient.TokenType:
+		return clusterID + ":" + id
+	}
+	return id
+}
+
+func fakeRancherRandomID(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = fakeRancherIDLetters[rand.Intn(len(fakeRancherIDLetters))]
+	}
+	return string(b)
+}
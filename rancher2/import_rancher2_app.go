@@ -0,0 +1,36 @@
+package rancher2
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceRancher2AppImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	clusterID, resourceID := splitID(d.Id())
+	projectShortID, _ := splitID(resourceID)
+	if clusterID == "" || projectShortID == "" {
+		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Bad app import ID format %s, expected <cluster_id>:<project_id>:<app_name>", d.Id())
+	}
+	projectID := clusterID + clusterProjectIDSeparator + projectShortID
+
+	client, err := meta.(*Config).ProjectClient(projectID)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	err = d.Set("project_id", projectID)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	app, err := client.App.ByID(resourceID)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	err = flattenApp(d, app)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -1,6 +1,9 @@
 package rancher2
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -9,7 +12,20 @@ func resourceRancher2CatalogImport(d *schema.ResourceData, meta interface{}) ([]
 	if err != nil {
 		return []*schema.ResourceData{}, err
 	}
-	catalog, err := client.Catalog.ByID(d.Id())
+
+	// Import ID is <catalog_name> on global, <cluster_id>:<catalog_name> on cluster and <cluster_id>:<project_id>:<catalog_name> on project scope
+	scope, id := catalogScopeGlobal, d.Id()
+	switch parts := strings.Split(d.Id(), ":"); len(parts) {
+	case 1:
+	case 2:
+		scope = catalogScopeCluster
+	case 3:
+		scope, id = catalogScopeProject, parts[1]+":"+parts[2]
+	default:
+		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Bad catalog import ID format %s, expected <catalog_name>, <cluster_id>:<catalog_name> or <cluster_id>:<project_id>:<catalog_name>", d.Id())
+	}
+
+	catalog, err := getCatalogByID(client, id, scope)
 	if err != nil {
 		return []*schema.ResourceData{}, err
 	}
@@ -0,0 +1,25 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func resourceRancher2GlobalRoleBindingImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	globalRoleBinding := &GlobalRoleBinding{}
+	err = client.APIBaseClient.ByID(managementClient.GlobalRoleBindingType, d.Id(), globalRoleBinding)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	err = flattenGlobalRoleBinding(d, globalRoleBinding)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
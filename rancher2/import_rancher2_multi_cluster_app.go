@@ -0,0 +1,28 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceRancher2MultiClusterAppImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	multiClusterApp, err := client.MultiClusterApp.ByID(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	externalID, err := getMultiClusterAppExternalID(client, multiClusterApp.TemplateVersionID)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	err = flattenMultiClusterApp(d, multiClusterApp, externalID)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,48 @@
+package rancher2
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+func resourceRancher2SecretImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	projectID, namespaceID, name, ok := splitProjectScopedID(d.Id())
+	if !ok {
+		return []*schema.ResourceData{}, fmt.Errorf("[ERROR] Bad secret import ID format %s, expected <cluster_id>:<project_id>:<secret_name> or <cluster_id>:<project_id>:<namespace_id>:<secret_name>", d.Id())
+	}
+
+	client, err := meta.(*Config).ProjectClient(projectID)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	_, id := splitID(projectID)
+	if len(namespaceID) > 0 {
+		id = namespaceID
+	}
+	id = id + clusterProjectIDSeparator + name
+
+	secret := &projectClient.Secret{}
+	err = client.APIBaseClient.ByID(secretType(namespaceID), id, secret)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	err = d.Set("project_id", projectID)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+	err = d.Set("namespace_id", namespaceID)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	err = flattenSecret(d, secret)
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -1,10 +1,21 @@
 package rancher2
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	providerDefaultLoginTTL    = 1 * time.Hour
+	providerDefaultSessionDesc = "Terraform provider session"
 )
 
 // CLIConfig used to store data from file.
@@ -13,20 +24,26 @@ type CLIConfig struct {
 	AccessKey string `json:"accessKey"`
 	SecretKey string `json:"secretKey"`
 	TokenKey  string `json:"tokenKey"`
-	CACerts   string `json:"caCerts"`
+	CACerts   string `json:"cacert"`
 	Insecure  bool   `json:"insecure,omitempty"`
 	URL       string `json:"url"`
 	Project   string `json:"project"`
 	Path      string `json:"path,omitempty"`
 }
 
+// CLIConfigFile used to store servers data from rancher cli file.
+type CLIConfigFile struct {
+	Servers       map[string]*CLIConfig `json:"Servers"`
+	CurrentServer string                `json:"CurrentServer"`
+}
+
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"api_url": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("RANCHER_URL", ""),
 				Description: descriptions["api_url"],
 			},
@@ -57,6 +74,12 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("RANCHER_TOKEN_KEY", ""),
 				Description: descriptions["token_key"],
 			},
+			"config_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("RANCHER_CONFIG", ""),
+				Description: descriptions["config_file"],
+			},
 			"ca_certs": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -66,12 +89,54 @@ func Provider() terraform.ResourceProvider {
 			"insecure": &schema.Schema{
 				Type:        schema.TypeBool,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("RANCHER_INSECURE", false),
+				DefaultFunc: schema.EnvDefaultFunc("RANCHER_INSECURE", nil),
 				Description: descriptions["insecure"],
 			},
+			"username": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("RANCHER_USERNAME", ""),
+				Description: descriptions["username"],
+			},
+			"password": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("RANCHER_PASSWORD", ""),
+				Description: descriptions["password"],
+			},
+			"login_ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("RANCHER_LOGIN_TTL", int(providerDefaultLoginTTL/time.Second)),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  descriptions["login_ttl"],
+			},
+			"max_retries": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("RANCHER_MAX_RETRIES", retryDefaultMax),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["max_retries"],
+			},
+			"retry_backoff": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("RANCHER_RETRY_BACKOFF", int(retryDefaultBackoff/time.Second)),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["retry_backoff"],
+			},
+			"debug_api": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("RANCHER_DEBUG_API", false),
+				Description: descriptions["debug_api"],
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"rancher2_app":                           resourceRancher2App(),
+			"rancher2_app_rollback":                  resourceRancher2AppRollback(),
 			"rancher2_auth_config_activedirectory":   resourceRancher2AuthConfigActiveDirectory(),
 			"rancher2_auth_config_adfs":              resourceRancher2AuthConfigADFS(),
 			"rancher2_auth_config_azuread":           resourceRancher2AuthConfigAzureAD(),
@@ -81,24 +146,44 @@ func Provider() terraform.ResourceProvider {
 			"rancher2_auth_config_ping":              resourceRancher2AuthConfigPing(),
 			"rancher2_bootstrap":                     resourceRancher2Bootstrap(),
 			"rancher2_catalog":                       resourceRancher2Catalog(),
+			"rancher2_certificate":                   resourceRancher2Certificate(),
 			"rancher2_cloud_credential":              resourceRancher2CloudCredential(),
 			"rancher2_cluster":                       resourceRancher2Cluster(),
+			"rancher2_cluster_alert_group":           resourceRancher2ClusterAlertGroup(),
+			"rancher2_cluster_alert_rule":            resourceRancher2ClusterAlertRule(),
 			"rancher2_cluster_driver":                resourceRancher2ClusterDriver(),
 			"rancher2_cluster_logging":               resourceRancher2ClusterLogging(),
 			"rancher2_cluster_role_template_binding": resourceRancher2ClusterRoleTemplateBinding(),
 			"rancher2_etcd_backup":                   resourceRancher2EtcdBackup(),
+			"rancher2_global_dns":                    resourceRancher2GlobalDNS(),
+			"rancher2_global_dns_provider":           resourceRancher2GlobalDNSProvider(),
+			"rancher2_global_role":                   resourceRancher2GlobalRole(),
+			"rancher2_global_role_binding":           resourceRancher2GlobalRoleBinding(),
+			"rancher2_multi_cluster_app":             resourceRancher2MultiClusterApp(),
 			"rancher2_node_driver":                   resourceRancher2NodeDriver(),
 			"rancher2_node_pool":                     resourceRancher2NodePool(),
 			"rancher2_node_template":                 resourceRancher2NodeTemplate(),
+			"rancher2_notifier":                      resourceRancher2Notifier(),
+			"rancher2_pod_security_policy_template":  resourceRancher2PodSecurityPolicyTemplate(),
 			"rancher2_project":                       resourceRancher2Project(),
+			"rancher2_project_alert_group":           resourceRancher2ProjectAlertGroup(),
+			"rancher2_project_alert_rule":            resourceRancher2ProjectAlertRule(),
 			"rancher2_project_logging":               resourceRancher2ProjectLogging(),
 			"rancher2_project_role_template_binding": resourceRancher2ProjectRoleTemplateBinding(),
 			"rancher2_namespace":                     resourceRancher2Namespace(),
+			"rancher2_registry":                      resourceRancher2Registry(),
+			"rancher2_role_template":                 resourceRancher2RoleTemplate(),
+			"rancher2_secret":                        resourceRancher2Secret(),
 			"rancher2_setting":                       resourceRancher2Setting(),
+			"rancher2_token":                         resourceRancher2Token(),
+			"rancher2_user":                          resourceRancher2User(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"rancher2_setting": dataSourceRancher2Setting(),
+			"rancher2_app_revisions":            dataSourceRancher2AppRevisions(),
+			"rancher2_catalog_template":         dataSourceRancher2CatalogTemplate(),
+			"rancher2_catalog_template_version": dataSourceRancher2CatalogTemplateVersion(),
+			"rancher2_setting":                  dataSourceRancher2Setting(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -121,7 +206,21 @@ func init() {
 
 		"api_url": "The URL to the rancher API",
 
+		"config_file": "Rancher CLI config file path, used to get rancher server connection parameters if not provided",
+
 		"bootstrap": "Bootstrap rancher server",
+
+		"username": "Local user name used to login to the rancher server and get a session token",
+
+		"password": "Local user password used to login to the rancher server and get a session token",
+
+		"login_ttl": "Session token TTL in seconds when login with username and password",
+
+		"max_retries": "Maximum number of retries for transient rancher API errors",
+
+		"retry_backoff": "Initial wait in seconds between retries, doubled on every retry",
+
+		"debug_api": "Log rancher API requests and responses at debug level, redacting sensitive fields",
 	}
 }
 
@@ -131,21 +230,59 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	secretKey := d.Get("secret_key").(string)
 	tokenKey := d.Get("token_key").(string)
 	caCerts := d.Get("ca_certs").(string)
-	insecure := d.Get("insecure").(bool)
+	insecure, insecureSet := d.GetOkExists("insecure")
 	bootstrap := d.Get("bootstrap").(bool)
+	username := d.Get("username").(string)
+	password := d.Get("password").(string)
+	loginTTL := d.Get("login_ttl").(int)
+	configFile := d.Get("config_file").(string)
+	maxRetries := d.Get("max_retries").(int)
+	retryBackoff := d.Get("retry_backoff").(int)
+	debugAPI := d.Get("debug_api").(bool)
+	projectID := ""
+
+	// Load connection parameters from rancher cli config file if not provided
+	if configFile != "" {
+		cliConfig, err := loadCLIConfigFile(configFile)
+		if err != nil {
+			return &Config{}, err
+		}
+
+		if apiURL == "" {
+			apiURL = cliConfig.URL
+		}
+		if caCerts == "" {
+			caCerts = cliConfig.CACerts
+		}
+		// Explicit insecure false must override config file value
+		if !insecureSet {
+			insecure = cliConfig.Insecure
+		}
+		if !bootstrap && tokenKey == "" && accessKey == "" && secretKey == "" && username == "" {
+			tokenKey = cliConfig.TokenKey
+			accessKey = cliConfig.AccessKey
+			secretKey = cliConfig.SecretKey
+		}
+		projectID = cliConfig.Project
+	}
 
 	if apiURL == "" {
 		return &Config{}, fmt.Errorf("[ERROR] No api_url provided")
 	}
 
 	config := &Config{
-		URL:       NormalizeURL(apiURL),
-		AccessKey: accessKey,
-		SecretKey: secretKey,
-		TokenKey:  tokenKey,
-		CACerts:   caCerts,
-		Insecure:  insecure,
-		Bootstrap: bootstrap,
+		URL:              NormalizeURL(apiURL),
+		AccessKey:        accessKey,
+		SecretKey:        secretKey,
+		TokenKey:         tokenKey,
+		CACerts:          caCerts,
+		Insecure:         insecure.(bool),
+		Bootstrap:        bootstrap,
+		LoginTTL:         time.Duration(loginTTL) * time.Second,
+		DefaultProjectID: projectID,
+		MaxRetries:       maxRetries,
+		RetryBackoff:     time.Duration(retryBackoff) * time.Second,
+		DebugAPI:         debugAPI,
 	}
 
 	// If bootstrap tokenkey accesskey nor secretkey can be provided
@@ -153,10 +290,28 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		if config.TokenKey != "" || config.AccessKey != "" || config.SecretKey != "" {
 			return &Config{}, fmt.Errorf("[ERROR] Bootsrap mode activated. Token_key or access_key and secret_key can not be provided")
 		}
+		if username != "" || password != "" {
+			return &Config{}, fmt.Errorf("[ERROR] Bootsrap mode activated. Username and password can not be provided")
+		}
 	} else {
+		// Login with username and password if provided
+		if username != "" || password != "" {
+			if username == "" || password == "" {
+				return &Config{}, fmt.Errorf("[ERROR] Username and password should be provided together")
+			}
+			if config.TokenKey != "" || config.AccessKey != "" || config.SecretKey != "" {
+				return &Config{}, fmt.Errorf("[ERROR] Username and password can not be provided with token_key or access_key and secret_key")
+			}
+
+			err := config.UserLogin(username, password)
+			if err != nil {
+				return &Config{}, err
+			}
+		}
+
 		// Else token or access key and secret key should be provided
 		if config.TokenKey == "" && (config.AccessKey == "" || config.SecretKey == "") {
-			return &Config{}, fmt.Errorf("[ERROR] No token_key nor access_key and secret_key are provided")
+			return &Config{}, fmt.Errorf("[ERROR] No token_key nor access_key and secret_key nor username and password are provided")
 		}
 
 		_, err := config.ManagementClient()
@@ -167,3 +322,30 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	return config, nil
 }
+
+func loadCLIConfigFile(path string) (*CLIConfig, error) {
+	filePath, err := homedir.Expand(path)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Expanding rancher config file path %s: %v", path, err)
+	}
+
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Reading rancher config file %s: %v", filePath, err)
+	}
+
+	cliConfigFile := &CLIConfigFile{}
+	err = json.Unmarshal(data, cliConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Parsing rancher config file %s: %v", filePath, err)
+	}
+
+	cliConfig, ok := cliConfigFile.Servers[cliConfigFile.CurrentServer]
+	if !ok || cliConfig == nil {
+		return nil, fmt.Errorf("[ERROR] Rancher config file %s: current server %s not found", filePath, cliConfigFile.CurrentServer)
+	}
+
+	log.Printf("[INFO] Loaded rancher server %s config from %s", cliConfigFile.CurrentServer, filePath)
+
+	return cliConfig, nil
+}
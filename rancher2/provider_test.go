@@ -2,8 +2,10 @@ package rancher2
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,6 +20,7 @@ var (
 	testAccProviders         map[string]terraform.ResourceProvider
 	testAccProvider          *schema.Provider
 	testAccRancher2ClusterID string
+	testAccFakeRancher       *fakeRancher
 )
 
 func init() {
@@ -41,6 +44,39 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestLoadCLIConfigFile(t *testing.T) {
+	file, err := ioutil.TempFile("", "cli2.json")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.Remove(file.Name())
+
+	data := `{"Servers":{"rancherDefault":{"accessKey":"token-test","secretKey":"secret","tokenKey":"token-test:secret","url":"https://rancher.test","project":"c-test:p-test","cacert":"cacert"}},"CurrentServer":"rancherDefault"}`
+	_, err = file.WriteString(data)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	file.Close()
+
+	expected := &CLIConfig{
+		AccessKey: "token-test",
+		SecretKey: "secret",
+		TokenKey:  "token-test:secret",
+		CACerts:   "cacert",
+		URL:       "https://rancher.test",
+		Project:   "c-test:p-test",
+	}
+
+	output, err := loadCLIConfigFile(file.Name())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Unexpected output from loadCLIConfigFile.\nExpected: %#v\nGiven:    %#v",
+			expected, output)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	err := testAccCheck()
 	if err != nil {
@@ -50,10 +86,22 @@ func testAccPreCheck(t *testing.T) {
 
 func testAccCheck() error {
 	if os.Getenv("TF_ACC") == "1" {
+		// Running acceptance tests against a local fake rancher API if RANCHER_URL is not set
+		if os.Getenv("RANCHER_URL") == "" && testAccFakeRancher == nil {
+			testAccFakeRancher = newFakeRancher()
+			os.Setenv("RANCHER_URL", testAccFakeRancher.URL())
+			os.Setenv("RANCHER_TOKEN_KEY", fakeRancherTokenKey)
+			if os.Getenv("RANCHER_ADMIN_PASS") == "" {
+				os.Setenv("RANCHER_ADMIN_PASS", fakeRancherAdminPass)
+			}
+		}
+
 		apiURL := os.Getenv("RANCHER_URL")
 		tokenKey := os.Getenv("RANCHER_TOKEN_KEY")
 		accessKey := os.Getenv("RANCHER_ACCESS_KEY")
 		secretKey := os.Getenv("RANCHER_SECRET_KEY")
+		username := os.Getenv("RANCHER_USERNAME")
+		password := os.Getenv("RANCHER_PASSWORD")
 		caCerts := os.Getenv("RANCHER_CA_CERTS")
 		insecure := false
 
@@ -71,8 +119,8 @@ func testAccCheck() error {
 			return fmt.Errorf("RANCHER_URL must be set for acceptance tests")
 		}
 
-		if tokenKey == "" && (accessKey == "" || secretKey == "") {
-			return fmt.Errorf("RANCHER_TOKEN_KEY or RANCHER_ACCESS_KEY and RANCHER_SECRET_KEY must be set for acceptance tests")
+		if tokenKey == "" && (accessKey == "" || secretKey == "") && (username == "" || password == "") {
+			return fmt.Errorf("RANCHER_TOKEN_KEY, RANCHER_ACCESS_KEY and RANCHER_SECRET_KEY or RANCHER_USERNAME and RANCHER_PASSWORD must be set for acceptance tests")
 		}
 
 		config := &Config{
@@ -83,6 +131,14 @@ func testAccCheck() error {
 			CACerts:   caCerts,
 			Insecure:  insecure,
 			Bootstrap: bootstrap,
+			LoginTTL:  providerDefaultLoginTTL,
+		}
+
+		if tokenKey == "" && (accessKey == "" || secretKey == "") {
+			err := config.UserLogin(username, password)
+			if err != nil {
+				return fmt.Errorf("%v", err)
+			}
 		}
 
 		err := testAccClusterDefaultName(config)
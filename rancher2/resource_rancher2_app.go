@@ -0,0 +1,269 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+func resourceRancher2App() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2AppCreate,
+		Read:   resourceRancher2AppRead,
+		Update: resourceRancher2AppUpdate,
+		Delete: resourceRancher2AppDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2AppImport,
+		},
+
+		Schema: appFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2AppCreate(d *schema.ResourceData, meta interface{}) error {
+	projectID, err := setDefaultProjectID(d, meta)
+	if err != nil {
+		return err
+	}
+
+	err = meta.(*Config).ProjectExist(projectID)
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*Config).ProjectClient(projectID)
+	if err != nil {
+		return err
+	}
+
+	app := expandApp(d)
+
+	log.Printf("[INFO] Creating App %s on Project ID %s", app.Name, projectID)
+
+	newApp, err := client.App.Create(app)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newApp.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"activating", "installing", "deploying"},
+		Target:     []string{"active"},
+		Refresh:    appStateRefreshFunc(client, newApp.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf("[ERROR] waiting for app (%s) to be created: %s", newApp.ID, waitErr)
+	}
+
+	return resourceRancher2AppRead(d, meta)
+}
+
+func resourceRancher2AppRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing App ID %s", d.Id())
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	app, err := client.App.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] App ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenApp(d, app)
+}
+
+func resourceRancher2AppUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating App ID %s", d.Id())
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	app, err := client.App.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Upgrading app if template or values have changed
+	if d.HasChange("catalog_name") || d.HasChange("template_name") || d.HasChange("template_version") || d.HasChange("answers") || d.HasChange("values_yaml") {
+		upgrade := expandAppUpgrade(d)
+
+		log.Printf("[INFO] Upgrading App ID %s to %s", d.Id(), upgrade.ExternalID)
+
+		err = client.App.ActionUpgrade(app, upgrade)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Upgrading App ID %s: %s", d.Id(), err)
+		}
+
+		err = waitForAppRevision(client, d.Id(), app.AppRevisionID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+
+		app, err = client.App.ByID(d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
+	update := map[string]interface{}{
+		"description": d.Get("description").(string),
+		"prune":       d.Get("prune").(bool),
+		"annotations": toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":      toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newApp, err := client.App.Update(app, update)
+	if err != nil {
+		return err
+	}
+
+	err = waitForAppActive(client, newApp.ID, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2AppRead(d, meta)
+}
+
+func resourceRancher2AppDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting App ID %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	app, err := client.App.ByID(id)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] App ID %s not found.", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.App.Delete(app)
+	if err != nil {
+		return fmt.Errorf("Error removing App: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for app (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active", "removing"},
+		Target:     []string{"removed"},
+		Refresh:    appStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for app (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForAppActive(client *projectClient.Client, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active", "activating", "installing", "deploying", "upgrading", "rollingback"},
+		Target:     []string{"active"},
+		Refresh:    appStateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf("[ERROR] waiting for app (%s) to be active: %s", id, waitErr)
+	}
+
+	return nil
+}
+
+// waitForAppRevision waits for an upgraded or rolled back app to be deployed on a revision other than revisionID
+func waitForAppRevision(client *projectClient.Client, id, revisionID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active", "activating", "installing", "deploying", "upgrading", "rollingback"},
+		Target:     []string{"deployed"},
+		Refresh:    appRevisionStateRefreshFunc(client, id, revisionID),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf("[ERROR] waiting for app (%s) to be deployed: %s", id, waitErr)
+	}
+
+	return nil
+}
+
+// appRevisionStateRefreshFunc returns deployed once app is active on a new revision and its Deployed condition is true
+func appRevisionStateRefreshFunc(client *projectClient.Client, appID, revisionID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, state, err := appStateRefreshFunc(client, appID)()
+		if err != nil || state != "active" {
+			return obj, state, err
+		}
+
+		app := obj.(*projectClient.App)
+		if app.AppRevisionID == revisionID {
+			return obj, state, nil
+		}
+		for _, condition := range app.Conditions {
+			if condition.Type == appConditionDeployed && condition.Status != "True" {
+				return obj, state, nil
+			}
+		}
+
+		return obj, "deployed", nil
+	}
+}
+
+// appStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher App.
+func appStateRefreshFunc(client *projectClient.Client, appID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.App.ByID(appID)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Transitioning == "error" {
+			return nil, "", fmt.Errorf("%s", obj.TransitioningMessage)
+		}
+
+		return obj, obj.State, nil
+	}
+}
@@ -0,0 +1,107 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceRancher2AppRollback() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2AppRollbackCreate,
+		Read:   resourceRancher2AppRollbackRead,
+		Update: resourceRancher2AppRollbackUpdate,
+		Delete: resourceRancher2AppRollbackDelete,
+
+		Schema: appRollbackFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2AppRollbackCreate(d *schema.ResourceData, meta interface{}) error {
+	err := resourceRancher2AppRollbackDo(d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("app_id").(string))
+
+	return resourceRancher2AppRollbackRead(d, meta)
+}
+
+func resourceRancher2AppRollbackRead(d *schema.ResourceData, meta interface{}) error {
+	appID := d.Get("app_id").(string)
+	log.Printf("[INFO] Refreshing App Rollback for App ID %s", appID)
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// Rollback creates a new app revision, so only app existence is checked
+	_, err = client.App.ByID(appID)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] App ID %s not found.", appID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func resourceRancher2AppRollbackUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("revision_id") {
+		err := resourceRancher2AppRollbackDo(d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceRancher2AppRollbackRead(d, meta)
+}
+
+func resourceRancher2AppRollbackDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting App Rollback for App ID %s. App is kept on its current revision", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func resourceRancher2AppRollbackDo(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	appID := d.Get("app_id").(string)
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	app, err := client.App.ByID(appID)
+	if err != nil {
+		return err
+	}
+
+	rollback := expandAppRollback(d)
+
+	// Rolling back to the current revision doesn't create a new one
+	if _, revisionName := splitID(rollback.RevisionID); revisionName == app.AppRevisionID {
+		log.Printf("[INFO] App ID %s is already on revision %s", appID, rollback.RevisionID)
+		return nil
+	}
+
+	log.Printf("[INFO] Rolling back App ID %s to revision %s", appID, rollback.RevisionID)
+
+	err = client.App.ActionRollback(app, rollback)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Rolling back App ID %s to revision %s: %s", appID, rollback.RevisionID, err)
+	}
+
+	return waitForAppRevision(client, appID, app.AppRevisionID, timeout)
+}
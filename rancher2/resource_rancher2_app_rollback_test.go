@@ -0,0 +1,105 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const (
+	testAccRancher2AppRollbackType = "rancher2_app_rollback"
+)
+
+const testAccRancher2AppRollbackConfig = testAccCheckRancher2AppRevisionsDataSourceConfig + `
+resource "rancher2_app_rollback" "foo" {
+  project_id = "${rancher2_app.foo.project_id}"
+  app_id = "${rancher2_app.foo.id}"
+  revision_id = "${data.rancher2_app_revisions.foo.revisions.0.id}"
+}
+`
+
+const testAccRancher2AppRollbackCurrentConfig = testAccCheckRancher2AppRevisionsDataSourceConfig + `
+resource "rancher2_app_rollback" "foo" {
+  project_id = "${rancher2_app.foo.project_id}"
+  app_id = "${rancher2_app.foo.id}"
+  revision_id = "${data.rancher2_app_revisions.foo.current_revision_id}"
+}
+`
+
+func TestAccRancher2AppRollback_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2AppDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2AppConfig,
+			},
+			resource.TestStep{
+				Config: testAccRancher2AppUpdateConfig,
+			},
+			resource.TestStep{
+				Config: testAccRancher2AppUpdateConfig + testAccRancher2AppRollbackConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testAccRancher2AppRollbackType+".foo", "revision_id", "data.rancher2_app_revisions.foo", "revisions.0.id"),
+					testAccCheckRancher2AppRollbackVersion(testAccRancher2AppRollbackType+".foo", "1.6.1"),
+				),
+				// rancher2_app template_version is rolled back outside its config
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRancher2AppRollback_currentRevision(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2AppDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2AppConfig,
+			},
+			resource.TestStep{
+				Config: testAccRancher2AppConfig + testAccRancher2AppRollbackCurrentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testAccRancher2AppRollbackType+".foo", "revision_id", "data.rancher2_app_revisions.foo", "current_revision_id"),
+					testAccCheckRancher2AppRollbackVersion(testAccRancher2AppRollbackType+".foo", "1.6.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRancher2AppRollbackVersion(n, version string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("App rollback not found: %s", n)
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ProjectClient(rs.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+
+		app, err := client.App.ByID(rs.Primary.Attributes["app_id"])
+		if err != nil {
+			return err
+		}
+
+		_, _, appVersion, err := splitAppExternalID(app.ExternalID)
+		if err != nil {
+			return err
+		}
+
+		if appVersion != version {
+			return fmt.Errorf("App version is %s, expected %s", appVersion, version)
+		}
+
+		return nil
+	}
+}
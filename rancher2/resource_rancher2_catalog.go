@@ -30,16 +30,37 @@ func resourceRancher2Catalog() *schema.Resource {
 }
 
 func resourceRancher2CatalogCreate(d *schema.ResourceData, meta interface{}) error {
-	catalog := expandCatalog(d)
+	scope := d.Get("scope").(string)
+	name := d.Get("name").(string)
+
+	switch {
+	case scope == catalogScopeCluster && len(d.Get("cluster_id").(string)) == 0:
+		return fmt.Errorf("[ERROR] Creating Catalog %s: cluster_id is required on %s scope", name, scope)
+	case scope == catalogScopeProject && len(d.Get("project_id").(string)) == 0:
+		return fmt.Errorf("[ERROR] Creating Catalog %s: project_id is required on %s scope", name, scope)
+	}
 
 	client, err := meta.(*Config).ManagementClient()
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Creating Catalog %s", catalog.Name)
+	log.Printf("[INFO] Creating Catalog %s on %s scope", name, scope)
+
+	var newCatalog interface{}
+	switch catalog := expandCatalog(d).(type) {
+	case *managementClient.ClusterCatalog:
+		newCatalog, err = client.ClusterCatalog.Create(catalog)
+	case *managementClient.ProjectCatalog:
+		newCatalog, err = client.ProjectCatalog.Create(catalog)
+	case *managementClient.Catalog:
+		newCatalog, err = client.Catalog.Create(catalog)
+	}
+	if err != nil {
+		return err
+	}
 
-	newCatalog, err := client.Catalog.Create(catalog)
+	err = flattenCatalog(d, newCatalog)
 	if err != nil {
 		return err
 	}
@@ -47,7 +68,7 @@ func resourceRancher2CatalogCreate(d *schema.ResourceData, meta interface{}) err
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"refreshed"},
 		Target:     []string{"active"},
-		Refresh:    catalogStateRefreshFunc(client, newCatalog.ID),
+		Refresh:    catalogStateRefreshFunc(client, d.Id(), scope),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      1 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -55,12 +76,7 @@ func resourceRancher2CatalogCreate(d *schema.ResourceData, meta interface{}) err
 	_, waitErr := stateConf.WaitForState()
 	if waitErr != nil {
 		return fmt.Errorf(
-			"[ERROR] waiting for catalog (%s) to be created: %s", newCatalog.ID, waitErr)
-	}
-
-	err = flattenCatalog(d, newCatalog)
-	if err != nil {
-		return err
+			"[ERROR] waiting for catalog (%s) to be created: %s", d.Id(), waitErr)
 	}
 
 	return resourceRancher2CatalogRead(d, meta)
@@ -73,7 +89,7 @@ func resourceRancher2CatalogRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	catalog, err := client.Catalog.ByID(d.Id())
+	catalog, err := getCatalogByID(client, d.Id(), d.Get("scope").(string))
 	if err != nil {
 		if IsNotFound(err) {
 			log.Printf("[INFO] Catalog ID %s not found.", d.Id())
@@ -93,12 +109,13 @@ func resourceRancher2CatalogRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceRancher2CatalogUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Updating Catalog ID %s", d.Id())
+	scope := d.Get("scope").(string)
 	client, err := meta.(*Config).ManagementClient()
 	if err != nil {
 		return err
 	}
 
-	catalog, err := client.Catalog.ByID(d.Id())
+	catalog, err := getCatalogByID(client, d.Id(), scope)
 	if err != nil {
 		return err
 	}
@@ -108,19 +125,62 @@ func resourceRancher2CatalogUpdate(d *schema.ResourceData, meta interface{}) err
 		"description": d.Get("description").(string),
 		"kind":        d.Get("kind").(string),
 		"branch":      d.Get("branch").(string),
+		"username":    d.Get("username").(string),
+		"password":    d.Get("password").(string),
 		"annotations": toMapString(d.Get("annotations").(map[string]interface{})),
 		"labels":      toMapString(d.Get("labels").(map[string]interface{})),
 	}
 
-	newCatalog, err := client.Catalog.Update(catalog, update)
+	var newCatalog interface{}
+	switch obj := catalog.(type) {
+	case *managementClient.ClusterCatalog:
+		newCatalog, err = client.ClusterCatalog.Update(obj, update)
+	case *managementClient.ProjectCatalog:
+		newCatalog, err = client.ProjectCatalog.Update(obj, update)
+	case *managementClient.Catalog:
+		newCatalog, err = client.Catalog.Update(obj, update)
+	}
 	if err != nil {
 		return err
 	}
 
+	// Any change on refresh argument triggers a catalog refresh
+	if d.HasChange("refresh") {
+		log.Printf("[INFO] Refreshing Catalog ID %s templates", d.Id())
+
+		lastRefresh, _ := catalogRefreshStatus(newCatalog)
+		switch obj := newCatalog.(type) {
+		case *managementClient.ClusterCatalog:
+			err = client.ClusterCatalog.ActionRefresh(obj)
+		case *managementClient.ProjectCatalog:
+			err = client.ProjectCatalog.ActionRefresh(obj)
+		case *managementClient.Catalog:
+			err = client.Catalog.ActionRefresh(obj)
+		}
+		if err != nil {
+			return fmt.Errorf("[ERROR] Refreshing Catalog ID %s: %s", d.Id(), err)
+		}
+
+		// Catalog state doesn't change while refreshing, waiting for a new refresh timestamp
+		refreshConf := &resource.StateChangeConf{
+			Pending:    []string{"refreshing"},
+			Target:     []string{"refreshed"},
+			Refresh:    catalogRefreshStateRefreshFunc(client, d.Id(), scope, lastRefresh),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      1 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		_, waitErr := refreshConf.WaitForState()
+		if waitErr != nil {
+			return fmt.Errorf(
+				"[ERROR] waiting for catalog (%s) to be refreshed: %s", d.Id(), waitErr)
+		}
+	}
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"refreshed"},
 		Target:     []string{"active"},
-		Refresh:    catalogStateRefreshFunc(client, newCatalog.ID),
+		Refresh:    catalogStateRefreshFunc(client, d.Id(), scope),
 		Timeout:    d.Timeout(schema.TimeoutUpdate),
 		Delay:      1 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -128,7 +188,7 @@ func resourceRancher2CatalogUpdate(d *schema.ResourceData, meta interface{}) err
 	_, waitErr := stateConf.WaitForState()
 	if waitErr != nil {
 		return fmt.Errorf(
-			"[ERROR] waiting for catalog (%s) to be updated: %s", newCatalog.ID, waitErr)
+			"[ERROR] waiting for catalog (%s) to be updated: %s", d.Id(), waitErr)
 	}
 
 	return resourceRancher2CatalogRead(d, meta)
@@ -137,12 +197,13 @@ func resourceRancher2CatalogUpdate(d *schema.ResourceData, meta interface{}) err
 func resourceRancher2CatalogDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Deleting catalog ID %s", d.Id())
 	id := d.Id()
+	scope := d.Get("scope").(string)
 	client, err := meta.(*Config).ManagementClient()
 	if err != nil {
 		return err
 	}
 
-	catalog, err := client.Catalog.ByID(id)
+	catalog, err := getCatalogByID(client, id, scope)
 	if err != nil {
 		if IsNotFound(err) {
 			log.Printf("[INFO] Catalog ID %s not found.", d.Id())
@@ -152,7 +213,14 @@ func resourceRancher2CatalogDelete(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	err = client.Catalog.Delete(catalog)
+	switch obj := catalog.(type) {
+	case *managementClient.ClusterCatalog:
+		err = client.ClusterCatalog.Delete(obj)
+	case *managementClient.ProjectCatalog:
+		err = client.ProjectCatalog.Delete(obj)
+	case *managementClient.Catalog:
+		err = client.Catalog.Delete(obj)
+	}
 	if err != nil {
 		return fmt.Errorf("Error removing Catalog: %s", err)
 	}
@@ -162,7 +230,7 @@ func resourceRancher2CatalogDelete(d *schema.ResourceData, meta interface{}) err
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"active"},
 		Target:     []string{"removed"},
-		Refresh:    catalogStateRefreshFunc(client, id),
+		Refresh:    catalogStateRefreshFunc(client, id, scope),
 		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      1 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -178,10 +246,22 @@ func resourceRancher2CatalogDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+// getCatalogByID returns the catalog of catalogID on scope, as *managementClient.Catalog, *managementClient.ClusterCatalog or *managementClient.ProjectCatalog
+func getCatalogByID(client *managementClient.Client, catalogID, scope string) (interface{}, error) {
+	switch scope {
+	case catalogScopeCluster:
+		return client.ClusterCatalog.ByID(catalogID)
+	case catalogScopeProject:
+		return client.ProjectCatalog.ByID(catalogID)
+	}
+
+	return client.Catalog.ByID(catalogID)
+}
+
 // catalogStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Catalog.
-func catalogStateRefreshFunc(client *managementClient.Client, catalogID string) resource.StateRefreshFunc {
+func catalogStateRefreshFunc(client *managementClient.Client, catalogID, scope string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		obj, err := client.Catalog.ByID(catalogID)
+		obj, err := getCatalogByID(client, catalogID, scope)
 		if err != nil {
 			if IsNotFound(err) {
 				return obj, "removed", nil
@@ -189,10 +269,59 @@ func catalogStateRefreshFunc(client *managementClient.Client, catalogID string)
 			return nil, "", err
 		}
 
-		if obj.Removed != "" {
+		var state, removed string
+		switch catalog := obj.(type) {
+		case *managementClient.ClusterCatalog:
+			state, removed = catalog.State, catalog.Removed
+		case *managementClient.ProjectCatalog:
+			state, removed = catalog.State, catalog.Removed
+		case *managementClient.Catalog:
+			state, removed = catalog.State, catalog.Removed
+		}
+
+		if removed != "" {
 			return obj, "removed", nil
 		}
 
-		return obj, obj.State, nil
+		return obj, state, nil
+	}
+}
+
+// catalogRefreshStatus returns catalog last refresh timestamp and if a refresh is in progress
+func catalogRefreshStatus(obj interface{}) (string, bool) {
+	var lastRefresh string
+	var conditions []managementClient.CatalogCondition
+	switch catalog := obj.(type) {
+	case *managementClient.ClusterCatalog:
+		lastRefresh, conditions = catalog.LastRefreshTimestamp, catalog.Conditions
+	case *managementClient.ProjectCatalog:
+		lastRefresh, conditions = catalog.LastRefreshTimestamp, catalog.Conditions
+	case *managementClient.Catalog:
+		lastRefresh, conditions = catalog.LastRefreshTimestamp, catalog.Conditions
+	}
+
+	for _, condition := range conditions {
+		if condition.Type == catalogConditionRefreshed && condition.Status == "Unknown" {
+			return lastRefresh, true
+		}
+	}
+
+	return lastRefresh, false
+}
+
+// catalogRefreshStateRefreshFunc returns refreshed once catalog lastRefreshTimestamp differs from lastRefresh
+func catalogRefreshStateRefreshFunc(client *managementClient.Client, catalogID, scope, lastRefresh string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := getCatalogByID(client, catalogID, scope)
+		if err != nil {
+			return nil, "", err
+		}
+
+		refreshTimestamp, refreshing := catalogRefreshStatus(obj)
+		if refreshing || refreshTimestamp == lastRefresh {
+			return obj, "refreshing", nil
+		}
+
+		return obj, "refreshed", nil
 	}
 }
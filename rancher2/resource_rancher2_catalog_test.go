@@ -25,6 +25,9 @@ resource "rancher2_catalog" "foo" {
   name = "foo"
   url = "http://foo.updated.com:8080"
   description= "Terraform catalog acceptance test - updated"
+  username = "user"
+  password = "pass"
+  refresh = "1"
 }
  `
 
@@ -37,6 +40,34 @@ resource "rancher2_catalog" "foo" {
  `
 )
 
+var (
+	testAccRancher2CatalogScopesConfig string
+)
+
+func init() {
+	testAccRancher2CatalogScopesConfig = `
+resource "rancher2_project" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform catalog acceptance test"
+}
+resource "rancher2_catalog" "foo-cluster" {
+  name = "foo-cluster"
+  url = "http://foo.com:8080"
+  description= "Terraform catalog acceptance test"
+  scope = "cluster"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+}
+resource "rancher2_catalog" "foo-project" {
+  name = "foo-project"
+  url = "http://foo.com:8080"
+  description= "Terraform catalog acceptance test"
+  scope = "project"
+  project_id = "${rancher2_project.foo.id}"
+}
+`
+}
+
 func TestAccRancher2Catalog_basic(t *testing.T) {
 	var catalog *managementClient.Catalog
 
@@ -61,6 +92,9 @@ func TestAccRancher2Catalog_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccRancher2CatalogType+".foo", "name", "foo"),
 					resource.TestCheckResourceAttr(testAccRancher2CatalogType+".foo", "description", "Terraform catalog acceptance test - updated"),
 					resource.TestCheckResourceAttr(testAccRancher2CatalogType+".foo", "url", "http://foo.updated.com:8080"),
+					resource.TestCheckResourceAttr(testAccRancher2CatalogType+".foo", "username", "user"),
+					resource.TestCheckResourceAttr(testAccRancher2CatalogType+".foo", "password", "pass"),
+					resource.TestCheckResourceAttr(testAccRancher2CatalogType+".foo", "refresh", "1"),
 				),
 			},
 			resource.TestStep{
@@ -76,6 +110,41 @@ func TestAccRancher2Catalog_basic(t *testing.T) {
 	})
 }
 
+func TestAccRancher2Catalog_scopes(t *testing.T) {
+	var catalog *managementClient.Catalog
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2CatalogDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2CatalogScopesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2CatalogExists(testAccRancher2CatalogType+".foo-cluster", catalog),
+					resource.TestCheckResourceAttr(testAccRancher2CatalogType+".foo-cluster", "scope", "cluster"),
+					resource.TestCheckResourceAttr(testAccRancher2CatalogType+".foo-cluster", "cluster_id", testAccRancher2ClusterID),
+					resource.TestCheckResourceAttr(testAccRancher2CatalogType+".foo-cluster", "id", testAccRancher2ClusterID+":foo-cluster"),
+					testAccCheckRancher2CatalogExists(testAccRancher2CatalogType+".foo-project", catalog),
+					resource.TestCheckResourceAttr(testAccRancher2CatalogType+".foo-project", "scope", "project"),
+					resource.TestCheckResourceAttrPair(testAccRancher2CatalogType+".foo-project", "project_id", "rancher2_project.foo", "id"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      testAccRancher2CatalogType + ".foo-cluster",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				ResourceName:        testAccRancher2CatalogType + ".foo-project",
+				ImportState:         true,
+				ImportStateIdPrefix: testAccRancher2ClusterID + ":",
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
 func TestAccRancher2Catalog_disappears(t *testing.T) {
 	var catalog *managementClient.Catalog
 
@@ -123,7 +192,7 @@ func testAccRancher2CatalogDisappears(cat *managementClient.Catalog) resource.Te
 			stateConf := &resource.StateChangeConf{
 				Pending:    []string{"active"},
 				Target:     []string{"removed"},
-				Refresh:    catalogStateRefreshFunc(client, cat.ID),
+				Refresh:    catalogStateRefreshFunc(client, cat.ID, catalogScopeGlobal),
 				Timeout:    10 * time.Minute,
 				Delay:      1 * time.Second,
 				MinTimeout: 3 * time.Second,
@@ -157,7 +226,7 @@ func testAccCheckRancher2CatalogExists(n string, cat *managementClient.Catalog)
 			return err
 		}
 
-		foundReg, err := client.Catalog.ByID(rs.Primary.ID)
+		foundReg, err := getCatalogByID(client, rs.Primary.ID, rs.Primary.Attributes["scope"])
 		if err != nil {
 			if IsNotFound(err) {
 				return fmt.Errorf("Catalog not found")
@@ -165,7 +234,9 @@ func testAccCheckRancher2CatalogExists(n string, cat *managementClient.Catalog)
 			return err
 		}
 
-		cat = foundReg
+		if foundCat, ok := foundReg.(*managementClient.Catalog); ok {
+			cat = foundCat
+		}
 
 		return nil
 	}
@@ -181,7 +252,7 @@ func testAccCheckRancher2CatalogDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = client.Catalog.ByID(rs.Primary.ID)
+		_, err = getCatalogByID(client, rs.Primary.ID, rs.Primary.Attributes["scope"])
 		if err != nil {
 			if IsNotFound(err) {
 				return nil
@@ -0,0 +1,234 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+func resourceRancher2Certificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2CertificateCreate,
+		Read:   resourceRancher2CertificateRead,
+		Update: resourceRancher2CertificateUpdate,
+		Delete: resourceRancher2CertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2CertificateImport,
+		},
+
+		Schema: certificateFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2CertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	projectID, err := setDefaultProjectID(d, meta)
+	if err != nil {
+		return err
+	}
+
+	err = meta.(*Config).ProjectExist(projectID)
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*Config).ProjectClient(projectID)
+	if err != nil {
+		return err
+	}
+
+	certificate, err := expandCertificate(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Certificate %s on Project ID %s", certificate.Name, projectID)
+
+	newCertificate := &projectClient.Certificate{}
+	err = client.APIBaseClient.Create(certificateType(certificate.NamespaceId), certificate, newCertificate)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"activating"},
+		Target:     []string{"active"},
+		Refresh:    certificateStateRefreshFunc(client, newCertificate.ID, certificate.NamespaceId),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for certificate (%s) to be created: %s", newCertificate.ID, waitErr)
+	}
+
+	d.SetId(newCertificate.ID)
+	d.Set("certs_checksum", GetSHA256Checksum(newCertificate.Certs))
+
+	return resourceRancher2CertificateRead(d, meta)
+}
+
+func resourceRancher2CertificateRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Certificate ID %s", d.Id())
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	certificate := &projectClient.Certificate{}
+	err = client.APIBaseClient.ByID(certificateType(d.Get("namespace_id").(string)), d.Id(), certificate)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Certificate ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenCertificate(d, certificate)
+}
+
+func resourceRancher2CertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Certificate ID %s", d.Id())
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	namespaceID := d.Get("namespace_id").(string)
+	certificate := &projectClient.Certificate{}
+	err = client.APIBaseClient.ByID(certificateType(namespaceID), d.Id(), certificate)
+	if err != nil {
+		return err
+	}
+
+	certs, err := Base64Decode(d.Get("certs").(string))
+	if err != nil {
+		return err
+	}
+
+	key, err := Base64Decode(d.Get("key").(string))
+	if err != nil {
+		return err
+	}
+
+	update := map[string]interface{}{
+		"description": d.Get("description").(string),
+		"certs":       certs,
+		"key":         key,
+		"annotations": toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":      toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newCertificate := &projectClient.Certificate{}
+	err = client.APIBaseClient.Update(certificateType(namespaceID), &certificate.Resource, update, newCertificate)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    certificateStateRefreshFunc(client, newCertificate.ID, namespaceID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for certificate (%s) to be updated: %s", newCertificate.ID, waitErr)
+	}
+
+	d.Set("certs_checksum", GetSHA256Checksum(newCertificate.Certs))
+
+	return resourceRancher2CertificateRead(d, meta)
+}
+
+func resourceRancher2CertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Certificate ID %s", d.Id())
+	id := d.Id()
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	namespaceID := d.Get("namespace_id").(string)
+	certificate := &projectClient.Certificate{}
+	err = client.APIBaseClient.ByID(certificateType(namespaceID), id, certificate)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Certificate ID %s not found.", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.APIBaseClient.Delete(&certificate.Resource)
+	if err != nil {
+		return fmt.Errorf("Error removing Certificate: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for certificate (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    certificateStateRefreshFunc(client, id, namespaceID),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for certificate (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// certificateType returns the certificate schema type, namespacedCertificate if namespaceID is set
+func certificateType(namespaceID string) string {
+	if len(namespaceID) > 0 {
+		return projectClient.NamespacedCertificateType
+	}
+	return projectClient.CertificateType
+}
+
+// certificateStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Certificate.
+func certificateStateRefreshFunc(client *projectClient.Client, certificateID, namespaceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj := &projectClient.Certificate{}
+		err := client.APIBaseClient.ByID(certificateType(namespaceID), certificateID, obj)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
@@ -74,6 +74,11 @@ func resourceRancher2ClusterCreate(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(newCluster.ID)
 
+	err = updateClusterMonitoring(client, d, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
 	return resourceRancher2ClusterRead(d, meta)
 }
 
@@ -118,6 +123,22 @@ func resourceRancher2ClusterRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	monitoringInput := &MonitoringInput{}
+	if cluster.EnableClusterMonitoring {
+		monitoringInput, err = viewClusterMonitoring(client, cluster)
+		if err != nil {
+			return err
+		}
+	}
+	if len(monitoringInput.Answers) > 0 || len(monitoringInput.Version) > 0 {
+		err = d.Set("cluster_monitoring_input", flattenClusterMonitoringInput(monitoringInput))
+	} else {
+		err = d.Set("cluster_monitoring_input", []interface{}{})
+	}
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -136,10 +157,11 @@ func resourceRancher2ClusterUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	update := map[string]interface{}{
-		"name":        d.Get("name").(string),
-		"description": d.Get("description").(string),
-		"annotations": toMapString(d.Get("annotations").(map[string]interface{})),
-		"labels":      toMapString(d.Get("labels").(map[string]interface{})),
+		"name":                               d.Get("name").(string),
+		"description":                        d.Get("description").(string),
+		"defaultPodSecurityPolicyTemplateId": d.Get("default_pod_security_policy_template_id").(string),
+		"annotations":                        toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":                             toMapString(d.Get("labels").(map[string]interface{})),
 	}
 
 	switch driver := d.Get("driver").(string); driver {
@@ -190,6 +212,13 @@ func resourceRancher2ClusterUpdate(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(newCluster.ID)
 
+	if d.HasChange("enable_cluster_monitoring") || d.HasChange("cluster_monitoring_input") {
+		err = updateClusterMonitoring(client, d, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceRancher2ClusterRead(d, meta)
 }
 
@@ -254,6 +283,51 @@ func clusterStateRefreshFunc(client *managementClient.Client, clusterID string)
 	}
 }
 
+// clusterMonitoringStateRefreshFunc returns a resource.StateRefreshFunc, used to watch Rancher Cluster monitoring deployment.
+// Monitoring is deployed once its conditions are true and rancher reports input as the cluster monitoring input
+func clusterMonitoringStateRefreshFunc(client *managementClient.Client, clusterID string, input *MonitoringInput) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj := &Cluster{}
+		err := client.APIBaseClient.ByID(managementClient.ClusterType, clusterID, obj)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if !obj.EnableClusterMonitoring {
+			return obj, "disabled", nil
+		}
+
+		// Monitoring is deployed by rancher once the cluster is provisioned
+		for _, state := range clusterMonitoringPendingStates {
+			if obj.State == state {
+				return obj, "enabled", nil
+			}
+		}
+
+		if obj.Transitioning == "yes" || obj.MonitoringStatus == nil || len(obj.MonitoringStatus.Conditions) == 0 {
+			return obj, "deploying", nil
+		}
+
+		for _, condition := range obj.MonitoringStatus.Conditions {
+			if condition.Status != "True" {
+				return obj, "deploying", nil
+			}
+		}
+
+		if input != nil {
+			monitoringInput, err := viewClusterMonitoring(client, obj)
+			if err != nil {
+				return nil, "", err
+			}
+			if !clusterMonitoringInputApplied(input, monitoringInput) {
+				return obj, "deploying", nil
+			}
+		}
+
+		return obj, "active", nil
+	}
+}
+
 // clusterRegistrationTokenStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher ClusterRegistrationToken.
 func clusterRegistrationTokenStateRefreshFunc(client *managementClient.Client, clusterID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -321,3 +395,95 @@ func createClusterRegistrationToken(client *managementClient.Client, clusterID s
 	}
 	return newRegToken, nil
 }
+
+// viewClusterMonitoring returns cluster monitoring input. It's only available from viewMonitoring action, which has the same answers and version fields
+func viewClusterMonitoring(client *managementClient.Client, cluster *Cluster) (*MonitoringInput, error) {
+	monitoringInput := &MonitoringInput{}
+	err := client.APIBaseClient.Action(managementClient.ClusterType, "viewMonitoring", &cluster.Resource, nil, monitoringInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return monitoringInput, nil
+}
+
+// clusterMonitoringInputApplied returns true if rancher monitoring input matches the requested one. Version is set by rancher if not requested
+func clusterMonitoringInputApplied(requested, current *MonitoringInput) bool {
+	if len(requested.Version) > 0 && requested.Version != current.Version {
+		return false
+	}
+	if len(requested.Answers) != len(current.Answers) {
+		return false
+	}
+	for k, v := range requested.Answers {
+		if value, ok := current.Answers[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// updateClusterMonitoring calls enableMonitoring, editMonitoring or disableMonitoring actions to match cluster monitoring arguments
+func updateClusterMonitoring(client *managementClient.Client, d *schema.ResourceData, timeout time.Duration) error {
+	cluster := &Cluster{}
+	err := client.APIBaseClient.ByID(managementClient.ClusterType, d.Id(), cluster)
+	if err != nil {
+		return err
+	}
+
+	enable := d.Get("enable_cluster_monitoring").(bool)
+
+	var action string
+	var input *MonitoringInput
+	switch {
+	case enable && !cluster.EnableClusterMonitoring:
+		action = "enableMonitoring"
+		input = expandClusterMonitoringInput(d.Get("cluster_monitoring_input").([]interface{}))
+	case enable && d.HasChange("cluster_monitoring_input"):
+		action = "editMonitoring"
+		input = expandClusterMonitoringInput(d.Get("cluster_monitoring_input").([]interface{}))
+		monitoringInput, err := viewClusterMonitoring(client, cluster)
+		if err != nil {
+			return err
+		}
+		if clusterMonitoringInputApplied(input, monitoringInput) {
+			log.Printf("[INFO] Cluster ID %s monitoring input is up to date", cluster.ID)
+			return nil
+		}
+	case !enable && cluster.EnableClusterMonitoring:
+		action = "disableMonitoring"
+	default:
+		return nil
+	}
+
+	log.Printf("[INFO] Calling %s action on Cluster ID %s", action, cluster.ID)
+
+	var actionInput interface{}
+	if input != nil {
+		actionInput = input
+	}
+	err = client.APIBaseClient.Action(managementClient.ClusterType, action, &cluster.Resource, actionInput, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Calling %s action on Cluster ID %s: %s", action, cluster.ID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deploying"},
+		Target:     []string{"active", "enabled"},
+		Refresh:    clusterMonitoringStateRefreshFunc(client, cluster.ID, input),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if !enable {
+		stateConf.Pending = []string{"active", "enabled", "deploying"}
+		stateConf.Target = []string{"disabled"}
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf("[ERROR] waiting for cluster (%s) monitoring to be updated: %s", cluster.ID, waitErr)
+	}
+
+	return nil
+}
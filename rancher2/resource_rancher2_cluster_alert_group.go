@@ -0,0 +1,208 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func resourceRancher2ClusterAlertGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2ClusterAlertGroupCreate,
+		Read:   resourceRancher2ClusterAlertGroupRead,
+		Update: resourceRancher2ClusterAlertGroupUpdate,
+		Delete: resourceRancher2ClusterAlertGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2ClusterAlertGroupImport,
+		},
+
+		Schema: clusterAlertGroupFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2ClusterAlertGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	clusterAlertGroup := expandClusterAlertGroup(d)
+
+	err := meta.(*Config).ClusterExist(clusterAlertGroup.ClusterID)
+	if err != nil {
+		return err
+	}
+
+	err = meta.(*Config).SetAlertRecipientsType(clusterAlertGroup.Recipients)
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Cluster Alert Group %s", clusterAlertGroup.Name)
+
+	newClusterAlertGroup, err := client.ClusterAlertGroup.Create(clusterAlertGroup)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"activating"},
+		Target:     []string{"active"},
+		Refresh:    clusterAlertGroupStateRefreshFunc(client, newClusterAlertGroup.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for cluster alert group (%s) to be created: %s", newClusterAlertGroup.ID, waitErr)
+	}
+
+	d.SetId(newClusterAlertGroup.ID)
+
+	return resourceRancher2ClusterAlertGroupRead(d, meta)
+}
+
+func resourceRancher2ClusterAlertGroupRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Cluster Alert Group ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	clusterAlertGroup, err := client.ClusterAlertGroup.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Cluster Alert Group ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenClusterAlertGroup(d, clusterAlertGroup)
+}
+
+func resourceRancher2ClusterAlertGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Cluster Alert Group ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	clusterAlertGroup, err := client.ClusterAlertGroup.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	recipients := expandAlertRecipients(d.Get("recipients").([]interface{}))
+	err = meta.(*Config).SetAlertRecipientsType(recipients)
+	if err != nil {
+		return err
+	}
+
+	update := map[string]interface{}{
+		"name":                  d.Get("name").(string),
+		"description":           d.Get("description").(string),
+		"groupIntervalSeconds":  int64(d.Get("group_interval_seconds").(int)),
+		"groupWaitSeconds":      int64(d.Get("group_wait_seconds").(int)),
+		"recipients":            recipients,
+		"repeatIntervalSeconds": int64(d.Get("repeat_interval_seconds").(int)),
+		"annotations":           toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":                toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newClusterAlertGroup, err := client.ClusterAlertGroup.Update(clusterAlertGroup, update)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    clusterAlertGroupStateRefreshFunc(client, newClusterAlertGroup.ID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for cluster alert group (%s) to be updated: %s", newClusterAlertGroup.ID, waitErr)
+	}
+
+	return resourceRancher2ClusterAlertGroupRead(d, meta)
+}
+
+func resourceRancher2ClusterAlertGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Cluster Alert Group ID %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	clusterAlertGroup, err := client.ClusterAlertGroup.ByID(id)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Cluster Alert Group ID %s not found.", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.ClusterAlertGroup.Delete(clusterAlertGroup)
+	if err != nil {
+		return fmt.Errorf("Error removing Cluster Alert Group: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for cluster alert group (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    clusterAlertGroupStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for cluster alert group (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// clusterAlertGroupStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Cluster Alert Group.
+func clusterAlertGroupStateRefreshFunc(client *managementClient.Client, clusterAlertGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.ClusterAlertGroup.ByID(clusterAlertGroupID)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
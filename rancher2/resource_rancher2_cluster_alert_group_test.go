@@ -0,0 +1,240 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	testAccRancher2ClusterAlertGroupType = "rancher2_cluster_alert_group"
+)
+
+var (
+	testAccRancher2ClusterAlertGroupConfig         string
+	testAccRancher2ClusterAlertGroupUpdateConfig   string
+	testAccRancher2ClusterAlertGroupRecreateConfig string
+)
+
+func init() {
+	testAccRancher2ClusterAlertGroupConfig = `
+resource "rancher2_notifier" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  slack_config {
+    default_recipient = "#foo"
+    url = "http://foo.com:8080"
+  }
+}
+resource "rancher2_cluster_alert_group" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform cluster alert group acceptance test"
+  group_interval_seconds = 180
+  recipients {
+    notifier_id = "${rancher2_notifier.foo.id}"
+  }
+}
+`
+
+	testAccRancher2ClusterAlertGroupUpdateConfig = `
+resource "rancher2_notifier" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  slack_config {
+    default_recipient = "#foo"
+    url = "http://foo.com:8080"
+  }
+}
+resource "rancher2_cluster_alert_group" "foo" {
+  name = "foo-updated"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform cluster alert group acceptance test - updated"
+  group_interval_seconds = 300
+  recipients {
+    notifier_id = "${rancher2_notifier.foo.id}"
+  }
+}
+ `
+
+	testAccRancher2ClusterAlertGroupRecreateConfig = `
+resource "rancher2_notifier" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  slack_config {
+    default_recipient = "#foo"
+    url = "http://foo.com:8080"
+  }
+}
+resource "rancher2_cluster_alert_group" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform cluster alert group acceptance test"
+  group_interval_seconds = 180
+  recipients {
+    notifier_id = "${rancher2_notifier.foo.id}"
+  }
+}
+ `
+}
+
+func TestAccRancher2ClusterAlertGroup_basic(t *testing.T) {
+	var alertGroup *managementClient.ClusterAlertGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2ClusterAlertGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2ClusterAlertGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ClusterAlertGroupExists(testAccRancher2ClusterAlertGroupType+".foo", alertGroup),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertGroupType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertGroupType+".foo", "description", "Terraform cluster alert group acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertGroupType+".foo", "group_interval_seconds", "180"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertGroupType+".foo", "recipients.0.notifier_type", alertRecipientTypeSlack),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2ClusterAlertGroupUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ClusterAlertGroupExists(testAccRancher2ClusterAlertGroupType+".foo", alertGroup),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertGroupType+".foo", "name", "foo-updated"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertGroupType+".foo", "description", "Terraform cluster alert group acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertGroupType+".foo", "group_interval_seconds", "300"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2ClusterAlertGroupRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ClusterAlertGroupExists(testAccRancher2ClusterAlertGroupType+".foo", alertGroup),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertGroupType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertGroupType+".foo", "description", "Terraform cluster alert group acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertGroupType+".foo", "group_interval_seconds", "180"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2ClusterAlertGroup_disappears(t *testing.T) {
+	var alertGroup *managementClient.ClusterAlertGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2ClusterAlertGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2ClusterAlertGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ClusterAlertGroupExists(testAccRancher2ClusterAlertGroupType+".foo", alertGroup),
+					testAccRancher2ClusterAlertGroupDisappears(alertGroup),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2ClusterAlertGroupDisappears(alertGroup *managementClient.ClusterAlertGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2ClusterAlertGroupType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ManagementClient()
+			if err != nil {
+				return err
+			}
+
+			alertGroup, err = client.ClusterAlertGroup.ByID(rs.Primary.ID)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.ClusterAlertGroup.Delete(alertGroup)
+			if err != nil {
+				return fmt.Errorf("Error removing Cluster Alert Group: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    clusterAlertGroupStateRefreshFunc(client, alertGroup.ID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for cluster alert group (%s) to be removed: %s", alertGroup.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+func testAccCheckRancher2ClusterAlertGroupExists(n string, alertGroup *managementClient.ClusterAlertGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No cluster alert group ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		foundAlertGroup, err := client.ClusterAlertGroup.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("Cluster alert group not found")
+			}
+			return err
+		}
+
+		alertGroup = foundAlertGroup
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2ClusterAlertGroupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2ClusterAlertGroupType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.ClusterAlertGroup.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("Cluster alert group still exists")
+	}
+	return nil
+}
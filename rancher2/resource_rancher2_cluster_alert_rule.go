@@ -0,0 +1,274 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func resourceRancher2ClusterAlertRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2ClusterAlertRuleCreate,
+		Read:   resourceRancher2ClusterAlertRuleRead,
+		Update: resourceRancher2ClusterAlertRuleUpdate,
+		Delete: resourceRancher2ClusterAlertRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2ClusterAlertRuleImport,
+		},
+
+		Schema: clusterAlertRuleFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2ClusterAlertRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	clusterAlertRule := expandClusterAlertRule(d)
+
+	if clusterAlertRule.EventRule == nil && clusterAlertRule.MetricRule == nil && clusterAlertRule.NodeRule == nil && clusterAlertRule.SystemServiceRule == nil {
+		return fmt.Errorf("[ERROR] Creating Cluster Alert Rule: event_rule, metric_rule, node_rule or system_service_rule should be provided")
+	}
+
+	err := meta.(*Config).ClusterExist(clusterAlertRule.ClusterID)
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Cluster Alert Rule %s", clusterAlertRule.Name)
+
+	newClusterAlertRule, err := client.ClusterAlertRule.Create(clusterAlertRule)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"activating"},
+		Target:     []string{"active"},
+		Refresh:    clusterAlertRuleStateRefreshFunc(client, newClusterAlertRule.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for cluster alert rule (%s) to be created: %s", newClusterAlertRule.ID, waitErr)
+	}
+
+	d.SetId(newClusterAlertRule.ID)
+
+	err = setClusterAlertRuleState(client, newClusterAlertRule.ID, d.Get("enabled").(bool), d.Get("muted").(bool))
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2ClusterAlertRuleRead(d, meta)
+}
+
+func resourceRancher2ClusterAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Cluster Alert Rule ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	clusterAlertRule, err := client.ClusterAlertRule.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Cluster Alert Rule ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenClusterAlertRule(d, clusterAlertRule)
+}
+
+func resourceRancher2ClusterAlertRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Cluster Alert Rule ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	clusterAlertRule, err := client.ClusterAlertRule.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Unset rules are sent as null, to allow changing the rule type
+	newRule := expandClusterAlertRule(d)
+	update := map[string]interface{}{
+		"groupId":               newRule.GroupID,
+		"name":                  newRule.Name,
+		"eventRule":             newRule.EventRule,
+		"groupIntervalSeconds":  newRule.GroupIntervalSeconds,
+		"groupWaitSeconds":      newRule.GroupWaitSeconds,
+		"inherited":             newRule.Inherited,
+		"metricRule":            newRule.MetricRule,
+		"nodeRule":              newRule.NodeRule,
+		"repeatIntervalSeconds": newRule.RepeatIntervalSeconds,
+		"severity":              newRule.Severity,
+		"systemServiceRule":     newRule.SystemServiceRule,
+		"annotations":           toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":                toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newClusterAlertRule, err := client.ClusterAlertRule.Update(clusterAlertRule, update)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    clusterAlertRuleStateRefreshFunc(client, newClusterAlertRule.ID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for cluster alert rule (%s) to be updated: %s", newClusterAlertRule.ID, waitErr)
+	}
+
+	if d.HasChange("enabled") || d.HasChange("muted") {
+		err = setClusterAlertRuleState(client, newClusterAlertRule.ID, d.Get("enabled").(bool), d.Get("muted").(bool))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceRancher2ClusterAlertRuleRead(d, meta)
+}
+
+func resourceRancher2ClusterAlertRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Cluster Alert Rule ID %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	clusterAlertRule, err := client.ClusterAlertRule.ByID(id)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Cluster Alert Rule ID %s not found.", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.ClusterAlertRule.Delete(clusterAlertRule)
+	if err != nil {
+		return fmt.Errorf("Error removing Cluster Alert Rule: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for cluster alert rule (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    clusterAlertRuleStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for cluster alert rule (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// clusterAlertRuleStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Cluster Alert Rule.
+func clusterAlertRuleStateRefreshFunc(client *managementClient.Client, clusterAlertRuleID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.ClusterAlertRule.ByID(clusterAlertRuleID)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
+
+// setClusterAlertRuleState calls activate, deactivate, mute or unmute actions to match enabled and muted arguments
+func setClusterAlertRuleState(client *managementClient.Client, clusterAlertRuleID string, enabled, muted bool) error {
+	clusterAlertRule, err := client.ClusterAlertRule.ByID(clusterAlertRuleID)
+	if err != nil {
+		return err
+	}
+
+	if !enabled {
+		if clusterAlertRule.AlertState == alertRuleStateInactive {
+			return nil
+		}
+		log.Printf("[INFO] Deactivating Cluster Alert Rule ID %s", clusterAlertRuleID)
+		err = client.ClusterAlertRule.ActionDeactivate(clusterAlertRule)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Deactivating Cluster Alert Rule ID %s: %s", clusterAlertRuleID, err)
+		}
+		return nil
+	}
+
+	if clusterAlertRule.AlertState == alertRuleStateInactive {
+		log.Printf("[INFO] Activating Cluster Alert Rule ID %s", clusterAlertRuleID)
+		err = client.ClusterAlertRule.ActionActivate(clusterAlertRule)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Activating Cluster Alert Rule ID %s: %s", clusterAlertRuleID, err)
+		}
+		if !muted {
+			return nil
+		}
+		// Refreshing the rule to get its mute action
+		clusterAlertRule, err = client.ClusterAlertRule.ByID(clusterAlertRuleID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if muted && clusterAlertRule.AlertState != alertRuleStateMuted {
+		log.Printf("[INFO] Muting Cluster Alert Rule ID %s", clusterAlertRuleID)
+		err = client.ClusterAlertRule.ActionMute(clusterAlertRule)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Muting Cluster Alert Rule ID %s: %s", clusterAlertRuleID, err)
+		}
+	}
+
+	if !muted && clusterAlertRule.AlertState == alertRuleStateMuted {
+		log.Printf("[INFO] Unmuting Cluster Alert Rule ID %s", clusterAlertRuleID)
+		err = client.ClusterAlertRule.ActionUnmute(clusterAlertRule)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Unmuting Cluster Alert Rule ID %s: %s", clusterAlertRuleID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,266 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	testAccRancher2ClusterAlertRuleType = "rancher2_cluster_alert_rule"
+)
+
+var (
+	testAccRancher2ClusterAlertRuleConfig         string
+	testAccRancher2ClusterAlertRuleUpdateConfig   string
+	testAccRancher2ClusterAlertRuleRecreateConfig string
+	testAccRancher2ClusterAlertRuleDisableConfig  string
+)
+
+func init() {
+	testAccRancher2ClusterAlertRuleConfig = `
+resource "rancher2_cluster_alert_group" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform cluster alert rule acceptance test"
+}
+resource "rancher2_cluster_alert_rule" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  group_id = "${rancher2_cluster_alert_group.foo.id}"
+  severity = "warning"
+  node_rule {
+    condition = "cpu"
+    cpu_threshold = 80
+  }
+}
+`
+
+	testAccRancher2ClusterAlertRuleUpdateConfig = `
+resource "rancher2_cluster_alert_group" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform cluster alert rule acceptance test"
+}
+resource "rancher2_cluster_alert_rule" "foo" {
+  name = "foo-updated"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  group_id = "${rancher2_cluster_alert_group.foo.id}"
+  severity = "warning"
+  muted = true
+  metric_rule {
+    duration = "5m"
+    expression = "cluster:cpu_usage"
+    comparison = "greater-than"
+    threshold_value = 0.8
+  }
+}
+ `
+
+	testAccRancher2ClusterAlertRuleRecreateConfig = `
+resource "rancher2_cluster_alert_group" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform cluster alert rule acceptance test"
+}
+resource "rancher2_cluster_alert_rule" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  group_id = "${rancher2_cluster_alert_group.foo.id}"
+  severity = "warning"
+  node_rule {
+    condition = "cpu"
+    cpu_threshold = 80
+  }
+}
+ `
+
+	testAccRancher2ClusterAlertRuleDisableConfig = `
+resource "rancher2_cluster_alert_group" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform cluster alert rule acceptance test"
+}
+resource "rancher2_cluster_alert_rule" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  group_id = "${rancher2_cluster_alert_group.foo.id}"
+  severity = "warning"
+  enabled = false
+  node_rule {
+    condition = "cpu"
+    cpu_threshold = 80
+  }
+}
+ `
+}
+
+func TestAccRancher2ClusterAlertRule_basic(t *testing.T) {
+	var alertRule *managementClient.ClusterAlertRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2ClusterAlertRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2ClusterAlertRuleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ClusterAlertRuleExists(testAccRancher2ClusterAlertRuleType+".foo", alertRule),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "severity", "warning"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "node_rule.0.cpu_threshold", "80"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "muted", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2ClusterAlertRuleUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ClusterAlertRuleExists(testAccRancher2ClusterAlertRuleType+".foo", alertRule),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "name", "foo-updated"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "node_rule.#", "0"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "metric_rule.0.expression", "cluster:cpu_usage"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "muted", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2ClusterAlertRuleRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ClusterAlertRuleExists(testAccRancher2ClusterAlertRuleType+".foo", alertRule),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "metric_rule.#", "0"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "node_rule.0.condition", "cpu"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "muted", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2ClusterAlertRuleDisableConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ClusterAlertRuleExists(testAccRancher2ClusterAlertRuleType+".foo", alertRule),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterAlertRuleType+".foo", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2ClusterAlertRule_disappears(t *testing.T) {
+	var alertRule *managementClient.ClusterAlertRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2ClusterAlertRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2ClusterAlertRuleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ClusterAlertRuleExists(testAccRancher2ClusterAlertRuleType+".foo", alertRule),
+					testAccRancher2ClusterAlertRuleDisappears(alertRule),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2ClusterAlertRuleDisappears(alertRule *managementClient.ClusterAlertRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2ClusterAlertRuleType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ManagementClient()
+			if err != nil {
+				return err
+			}
+
+			alertRule, err = client.ClusterAlertRule.ByID(rs.Primary.ID)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.ClusterAlertRule.Delete(alertRule)
+			if err != nil {
+				return fmt.Errorf("Error removing Cluster Alert Rule: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    clusterAlertRuleStateRefreshFunc(client, alertRule.ID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for cluster alert rule (%s) to be removed: %s", alertRule.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+func testAccCheckRancher2ClusterAlertRuleExists(n string, alertRule *managementClient.ClusterAlertRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No cluster alert rule ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		foundAlertGroup, err := client.ClusterAlertRule.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("Cluster alert rule not found")
+			}
+			return err
+		}
+
+		alertRule = foundAlertGroup
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2ClusterAlertRuleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2ClusterAlertRuleType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.ClusterAlertRule.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("Cluster alert rule still exists")
+	}
+	return nil
+}
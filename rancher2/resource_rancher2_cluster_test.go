@@ -35,6 +35,7 @@ resource "rancher2_cluster" "foo" {
 resource "rancher2_cluster" "foo" {
   name = "foo"
   description = "Terraform custom cluster acceptance test - updated"
+  default_pod_security_policy_template_id = "restricted"
   rke_config {
     network {
       plugin = "canal"
@@ -78,6 +79,12 @@ resource "rancher2_cluster" "foo" {
 resource "rancher2_cluster" "foo" {
   name = "foo"
   description = "Terraform imported cluster acceptance test - updated"
+  enable_cluster_monitoring = true
+  cluster_monitoring_input {
+    answers = {
+      "prometheus.retention" = "12h"
+    }
+  }
 }
  `
 
@@ -87,6 +94,48 @@ resource "rancher2_cluster" "foo" {
   description = "Terraform imported cluster acceptance test"
 }
  `
+
+	testAccRancher2ClusterConfigMonitoring = `
+resource "rancher2_cluster" "foo" {
+  name = "foo"
+  description = "Terraform monitoring cluster acceptance test"
+  eks_config {
+    access_key = "XXXXXXXXXXXXXXXXXXXX"
+    secret_key = "YYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY"
+    security_groups = ["sg-foo"]
+    service_role = "foo"
+    subnets = ["subnet-foo"]
+    virtual_network = "vpc-foo"
+  }
+  enable_cluster_monitoring = true
+  cluster_monitoring_input {
+    answers = {
+      "prometheus.retention" = "12h"
+    }
+  }
+}
+`
+
+	testAccRancher2ClusterUpdateConfigMonitoring = `
+resource "rancher2_cluster" "foo" {
+  name = "foo"
+  description = "Terraform monitoring cluster acceptance test"
+  eks_config {
+    access_key = "XXXXXXXXXXXXXXXXXXXX"
+    secret_key = "YYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY"
+    security_groups = ["sg-foo"]
+    service_role = "foo"
+    subnets = ["subnet-foo"]
+    virtual_network = "vpc-foo"
+  }
+  enable_cluster_monitoring = true
+  cluster_monitoring_input {
+    answers = {
+      "prometheus.retention" = "24h"
+    }
+  }
+}
+`
 )
 
 func TestAccRancher2Cluster_basic_RKE(t *testing.T) {
@@ -113,6 +162,7 @@ func TestAccRancher2Cluster_basic_RKE(t *testing.T) {
 					testAccCheckRancher2ClusterExists(testAccRancher2ClusterType+".foo", cluster),
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "name", "foo"),
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "description", "Terraform custom cluster acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "default_pod_security_policy_template_id", "restricted"),
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "rke_config.0.services.0.etcd.0.creation", "12h"),
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "rke_config.0.services.0.etcd.0.retention", "72h"),
 				),
@@ -123,6 +173,7 @@ func TestAccRancher2Cluster_basic_RKE(t *testing.T) {
 					testAccCheckRancher2ClusterExists(testAccRancher2ClusterType+".foo", cluster),
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "name", "foo"),
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "description", "Terraform custom cluster acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "default_pod_security_policy_template_id", ""),
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "rke_config.0.services.0.etcd.0.creation", "6h"),
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "rke_config.0.services.0.etcd.0.retention", "24h"),
 				),
@@ -175,6 +226,8 @@ func TestAccRancher2Cluster_basic_Imported(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "name", "foo"),
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "description", "Terraform imported cluster acceptance test - updated"),
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "driver", ""),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "enable_cluster_monitoring", "true"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "cluster_monitoring_input.0.answers.prometheus.retention", "12h"),
 				),
 			},
 			resource.TestStep{
@@ -184,6 +237,37 @@ func TestAccRancher2Cluster_basic_Imported(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "name", "foo"),
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "description", "Terraform imported cluster acceptance test"),
 					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "driver", ""),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "enable_cluster_monitoring", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2Cluster_monitoring(t *testing.T) {
+	var cluster *Cluster
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2ClusterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2ClusterConfigMonitoring,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ClusterExists(testAccRancher2ClusterType+".foo", cluster),
+					testAccCheckRancher2ClusterMonitoringDeployed(testAccRancher2ClusterType+".foo"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "enable_cluster_monitoring", "true"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "cluster_monitoring_input.0.answers.prometheus.retention", "12h"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2ClusterUpdateConfigMonitoring,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ClusterExists(testAccRancher2ClusterType+".foo", cluster),
+					testAccCheckRancher2ClusterMonitoringDeployed(testAccRancher2ClusterType+".foo"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "enable_cluster_monitoring", "true"),
+					resource.TestCheckResourceAttr(testAccRancher2ClusterType+".foo", "cluster_monitoring_input.0.answers.prometheus.retention", "24h"),
 				),
 			},
 		},
@@ -287,6 +371,31 @@ func testAccCheckRancher2ClusterExists(n string, pro *Cluster) resource.TestChec
 	}
 }
 
+func testAccCheckRancher2ClusterMonitoringDeployed(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		_, state, err := clusterMonitoringStateRefreshFunc(client, rs.Primary.ID, nil)()
+		if err != nil {
+			return err
+		}
+		if state != "active" {
+			return fmt.Errorf("Cluster monitoring is %s", state)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckRancher2ClusterDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != testAccRancher2ClusterType {
@@ -108,11 +108,11 @@ func resourceRancher2EtcdBackupUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	update := map[string]interface{}{
-		"backup_config": expandClusterRKEConfigServicesEtcdBackupConfig(d.Get("backup_config").([]interface{})),
-		"filename":      d.Get("filename").(string),
-		"manual":        d.Get("manual").(bool),
-		"annotations":   toMapString(d.Get("annotations").(map[string]interface{})),
-		"labels":        toMapString(d.Get("labels").(map[string]interface{})),
+		"backupConfig": expandClusterRKEConfigServicesEtcdBackupConfig(d.Get("backup_config").([]interface{})),
+		"filename":     d.Get("filename").(string),
+		"manual":       d.Get("manual").(bool),
+		"annotations":  toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":       toMapString(d.Get("labels").(map[string]interface{})),
 	}
 
 	newEtcdBackup, err := client.EtcdBackup.Update(etcdBackup, update)
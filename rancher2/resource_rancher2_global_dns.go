@@ -0,0 +1,222 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func resourceRancher2GlobalDNS() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2GlobalDNSCreate,
+		Read:   resourceRancher2GlobalDNSRead,
+		Update: resourceRancher2GlobalDNSUpdate,
+		Delete: resourceRancher2GlobalDNSDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2GlobalDNSImport,
+		},
+
+		Schema: globalDNSFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2GlobalDNSCreate(d *schema.ResourceData, meta interface{}) error {
+	globalDNS := expandGlobalDNS(d)
+
+	if len(globalDNS.ProjectIDs) == 0 && len(globalDNS.MultiClusterAppID) == 0 {
+		return fmt.Errorf("[ERROR] Creating Global DNS: project_ids or multi_cluster_app_id should be provided")
+	}
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Global DNS %s", globalDNS.Name)
+
+	newGlobalDNS, err := client.GlobalDNS.Create(globalDNS)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"activating"},
+		Target:     []string{"active"},
+		Refresh:    globalDNSStateRefreshFunc(client, newGlobalDNS.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for global dns (%s) to be created: %s", newGlobalDNS.ID, waitErr)
+	}
+
+	d.SetId(newGlobalDNS.ID)
+
+	return resourceRancher2GlobalDNSRead(d, meta)
+}
+
+func resourceRancher2GlobalDNSRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Global DNS ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	globalDNS, err := client.GlobalDNS.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Global DNS ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenGlobalDNS(d, globalDNS)
+}
+
+func resourceRancher2GlobalDNSUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Global DNS ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	globalDNS, err := client.GlobalDNS.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Adding and removing projects by action
+	if d.HasChange("project_ids") {
+		oldProjects, newProjects := d.GetChange("project_ids")
+		add, remove := expandGlobalDNSProjectsUpdate(oldProjects.([]interface{}), newProjects.([]interface{}))
+
+		if len(add.ProjectIDs) > 0 {
+			log.Printf("[INFO] Adding projects %v to Global DNS ID %s", add.ProjectIDs, d.Id())
+			err = client.GlobalDNS.ActionAddProjects(globalDNS, add)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Adding projects to Global DNS ID %s: %s", d.Id(), err)
+			}
+		}
+
+		if len(remove.ProjectIDs) > 0 {
+			log.Printf("[INFO] Removing projects %v from Global DNS ID %s", remove.ProjectIDs, d.Id())
+			err = client.GlobalDNS.ActionRemoveProjects(globalDNS, remove)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Removing projects from Global DNS ID %s: %s", d.Id(), err)
+			}
+		}
+
+		globalDNS, err = client.GlobalDNS.ByID(d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
+	update := map[string]interface{}{
+		"name":              d.Get("name").(string),
+		"fqdn":              d.Get("fqdn").(string),
+		"providerId":        d.Get("provider_id").(string),
+		"multiClusterAppId": d.Get("multi_cluster_app_id").(string),
+		"ttl":               int64(d.Get("ttl").(int)),
+		"annotations":       toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":            toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newGlobalDNS, err := client.GlobalDNS.Update(globalDNS, update)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    globalDNSStateRefreshFunc(client, newGlobalDNS.ID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for global dns (%s) to be updated: %s", newGlobalDNS.ID, waitErr)
+	}
+
+	return resourceRancher2GlobalDNSRead(d, meta)
+}
+
+func resourceRancher2GlobalDNSDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Global DNS ID %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	globalDNS, err := client.GlobalDNS.ByID(id)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Global DNS ID %s not found.", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.GlobalDNS.Delete(globalDNS)
+	if err != nil {
+		return fmt.Errorf("Error removing Global DNS: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for global dns (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    globalDNSStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for global dns (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// globalDNSStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Global DNS.
+func globalDNSStateRefreshFunc(client *managementClient.Client, globalDNSID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.GlobalDNS.ByID(globalDNSID)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
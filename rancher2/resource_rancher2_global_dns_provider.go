@@ -0,0 +1,197 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func resourceRancher2GlobalDNSProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2GlobalDNSProviderCreate,
+		Read:   resourceRancher2GlobalDNSProviderRead,
+		Update: resourceRancher2GlobalDNSProviderUpdate,
+		Delete: resourceRancher2GlobalDNSProviderDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2GlobalDNSProviderImport,
+		},
+
+		Schema: globalDNSProviderFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2GlobalDNSProviderCreate(d *schema.ResourceData, meta interface{}) error {
+	globalDNSProvider := expandGlobalDNSProvider(d)
+
+	if globalDNSProvider.AlidnsProviderConfig == nil && globalDNSProvider.CloudflareProviderConfig == nil && globalDNSProvider.Route53ProviderConfig == nil {
+		return fmt.Errorf("[ERROR] Creating Global DNS Provider: alidns_config, cloudflare_config or route53_config should be provided")
+	}
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Global DNS Provider %s", globalDNSProvider.Name)
+
+	newGlobalDNSProvider, err := client.GlobalDNSProvider.Create(globalDNSProvider)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"activating"},
+		Target:     []string{"active"},
+		Refresh:    globalDNSProviderStateRefreshFunc(client, newGlobalDNSProvider.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for global dns provider (%s) to be created: %s", newGlobalDNSProvider.ID, waitErr)
+	}
+
+	d.SetId(newGlobalDNSProvider.ID)
+
+	return resourceRancher2GlobalDNSProviderRead(d, meta)
+}
+
+func resourceRancher2GlobalDNSProviderRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Global DNS Provider ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	globalDNSProvider, err := client.GlobalDNSProvider.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Global DNS Provider ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenGlobalDNSProvider(d, globalDNSProvider)
+}
+
+func resourceRancher2GlobalDNSProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Global DNS Provider ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	globalDNSProvider, err := client.GlobalDNSProvider.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Unset configs are sent as null, to allow changing the provider type
+	newConfig := expandGlobalDNSProvider(d)
+	update := map[string]interface{}{
+		"name":                     newConfig.Name,
+		"rootDomain":               newConfig.RootDomain,
+		"alidnsProviderConfig":     newConfig.AlidnsProviderConfig,
+		"cloudflareProviderConfig": newConfig.CloudflareProviderConfig,
+		"route53ProviderConfig":    newConfig.Route53ProviderConfig,
+		"annotations":              toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":                   toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newGlobalDNSProvider, err := client.GlobalDNSProvider.Update(globalDNSProvider, update)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    globalDNSProviderStateRefreshFunc(client, newGlobalDNSProvider.ID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for global dns provider (%s) to be updated: %s", newGlobalDNSProvider.ID, waitErr)
+	}
+
+	return resourceRancher2GlobalDNSProviderRead(d, meta)
+}
+
+func resourceRancher2GlobalDNSProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Global DNS Provider ID %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	globalDNSProvider, err := client.GlobalDNSProvider.ByID(id)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Global DNS Provider ID %s not found.", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.GlobalDNSProvider.Delete(globalDNSProvider)
+	if err != nil {
+		return fmt.Errorf("Error removing Global DNS Provider: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for global dns provider (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    globalDNSProviderStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for global dns provider (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// globalDNSProviderStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Global DNS Provider.
+func globalDNSProviderStateRefreshFunc(client *managementClient.Client, globalDNSProviderID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.GlobalDNSProvider.ByID(globalDNSProviderID)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
@@ -0,0 +1,219 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	testAccRancher2GlobalDNSProviderType = "rancher2_global_dns_provider"
+)
+
+var (
+	testAccRancher2GlobalDNSProviderConfig         string
+	testAccRancher2GlobalDNSProviderUpdateConfig   string
+	testAccRancher2GlobalDNSProviderRecreateConfig string
+)
+
+func init() {
+	testAccRancher2GlobalDNSProviderConfig = `
+resource "rancher2_global_dns_provider" "foo" {
+  name = "foo"
+  root_domain = "example.com"
+  route53_config {
+    access_key = "YYYYYYYYYYYYYYYYYYYY"
+    secret_key = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+    zone_type = "private"
+  }
+}
+`
+
+	testAccRancher2GlobalDNSProviderUpdateConfig = `
+resource "rancher2_global_dns_provider" "foo" {
+  name = "foo-updated"
+  root_domain = "updated.example.com"
+  cloudflare_config {
+    api_email = "test@example.com"
+    api_key = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+    proxy_setting = false
+  }
+}
+ `
+
+	testAccRancher2GlobalDNSProviderRecreateConfig = `
+resource "rancher2_global_dns_provider" "foo" {
+  name = "foo"
+  root_domain = "example.com"
+  route53_config {
+    access_key = "YYYYYYYYYYYYYYYYYYYY"
+    secret_key = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+    zone_type = "private"
+  }
+}
+ `
+}
+
+func TestAccRancher2GlobalDNSProvider_basic(t *testing.T) {
+	var globalDNSProvider *managementClient.GlobalDNSProvider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2GlobalDNSProviderDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2GlobalDNSProviderConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalDNSProviderExists(testAccRancher2GlobalDNSProviderType+".foo", globalDNSProvider),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "root_domain", "example.com"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "route53_config.0.region", "us-west-2"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "route53_config.0.zone_type", "private"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2GlobalDNSProviderUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalDNSProviderExists(testAccRancher2GlobalDNSProviderType+".foo", globalDNSProvider),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "name", "foo-updated"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "root_domain", "updated.example.com"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "route53_config.#", "0"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "cloudflare_config.0.api_email", "test@example.com"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "cloudflare_config.0.proxy_setting", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2GlobalDNSProviderRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalDNSProviderExists(testAccRancher2GlobalDNSProviderType+".foo", globalDNSProvider),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "root_domain", "example.com"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "cloudflare_config.#", "0"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSProviderType+".foo", "route53_config.0.zone_type", "private"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2GlobalDNSProvider_disappears(t *testing.T) {
+	var globalDNSProvider *managementClient.GlobalDNSProvider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2GlobalDNSProviderDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2GlobalDNSProviderConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalDNSProviderExists(testAccRancher2GlobalDNSProviderType+".foo", globalDNSProvider),
+					testAccRancher2GlobalDNSProviderDisappears(globalDNSProvider),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2GlobalDNSProviderDisappears(globalDNSProvider *managementClient.GlobalDNSProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2GlobalDNSProviderType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ManagementClient()
+			if err != nil {
+				return err
+			}
+
+			globalDNSProvider, err = client.GlobalDNSProvider.ByID(rs.Primary.ID)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.GlobalDNSProvider.Delete(globalDNSProvider)
+			if err != nil {
+				return fmt.Errorf("Error removing Global DNS Provider: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    globalDNSProviderStateRefreshFunc(client, globalDNSProvider.ID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for globalDNSProvider (%s) to be removed: %s", globalDNSProvider.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+func testAccCheckRancher2GlobalDNSProviderExists(n string, globalDNSProvider *managementClient.GlobalDNSProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No globalDNSProvider ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		foundGlobalDNSProvider, err := client.GlobalDNSProvider.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("GlobalDNSProvider not found")
+			}
+			return err
+		}
+
+		globalDNSProvider = foundGlobalDNSProvider
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2GlobalDNSProviderDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2GlobalDNSProviderType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GlobalDNSProvider.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("GlobalDNSProvider still exists")
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	testAccRancher2GlobalDNSType = "rancher2_global_dns"
+)
+
+var (
+	testAccRancher2GlobalDNSProvider       string
+	testAccRancher2GlobalDNSConfig         string
+	testAccRancher2GlobalDNSUpdateConfig   string
+	testAccRancher2GlobalDNSRecreateConfig string
+)
+
+func init() {
+	testAccRancher2GlobalDNSProvider = `
+resource "rancher2_global_dns_provider" "foo" {
+  name = "foo"
+  root_domain = "example.com"
+  route53_config {
+    access_key = "YYYYYYYYYYYYYYYYYYYY"
+    secret_key = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+    zone_type = "private"
+  }
+}
+resource "rancher2_project" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform global dns acceptance test"
+}
+resource "rancher2_project" "bar" {
+  name = "bar"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform global dns acceptance test"
+}
+`
+	testAccRancher2GlobalDNSConfig = testAccRancher2GlobalDNSProvider + `
+resource "rancher2_global_dns" "foo" {
+  name = "foo"
+  fqdn = "foo.example.com"
+  provider_id = "${rancher2_global_dns_provider.foo.id}"
+  project_ids = ["${rancher2_project.foo.id}"]
+}
+`
+
+	testAccRancher2GlobalDNSUpdateConfig = testAccRancher2GlobalDNSProvider + `
+resource "rancher2_global_dns" "foo" {
+  name = "foo-updated"
+  fqdn = "foo-updated.example.com"
+  provider_id = "${rancher2_global_dns_provider.foo.id}"
+  project_ids = ["${rancher2_project.foo.id}", "${rancher2_project.bar.id}"]
+  ttl = 600
+}
+ `
+
+	testAccRancher2GlobalDNSRecreateConfig = testAccRancher2GlobalDNSProvider + `
+resource "rancher2_global_dns" "foo" {
+  name = "foo"
+  fqdn = "foo.example.com"
+  provider_id = "${rancher2_global_dns_provider.foo.id}"
+  project_ids = ["${rancher2_project.foo.id}"]
+}
+ `
+}
+
+func TestAccRancher2GlobalDNS_basic(t *testing.T) {
+	var globalDNS *managementClient.GlobalDNS
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2GlobalDNSDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2GlobalDNSConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalDNSExists(testAccRancher2GlobalDNSType+".foo", globalDNS),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSType+".foo", "fqdn", "foo.example.com"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSType+".foo", "ttl", "300"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSType+".foo", "project_ids.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2GlobalDNSUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalDNSExists(testAccRancher2GlobalDNSType+".foo", globalDNS),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSType+".foo", "name", "foo-updated"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSType+".foo", "fqdn", "foo-updated.example.com"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSType+".foo", "ttl", "600"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSType+".foo", "project_ids.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2GlobalDNSRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalDNSExists(testAccRancher2GlobalDNSType+".foo", globalDNS),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSType+".foo", "fqdn", "foo.example.com"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSType+".foo", "ttl", "300"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalDNSType+".foo", "project_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2GlobalDNS_disappears(t *testing.T) {
+	var globalDNS *managementClient.GlobalDNS
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2GlobalDNSDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2GlobalDNSConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalDNSExists(testAccRancher2GlobalDNSType+".foo", globalDNS),
+					testAccRancher2GlobalDNSDisappears(globalDNS),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2GlobalDNSDisappears(globalDNS *managementClient.GlobalDNS) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2GlobalDNSType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ManagementClient()
+			if err != nil {
+				return err
+			}
+
+			globalDNS, err = client.GlobalDNS.ByID(rs.Primary.ID)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.GlobalDNS.Delete(globalDNS)
+			if err != nil {
+				return fmt.Errorf("Error removing Global DNS: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    globalDNSStateRefreshFunc(client, globalDNS.ID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for globalDNS (%s) to be removed: %s", globalDNS.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+func testAccCheckRancher2GlobalDNSExists(n string, globalDNS *managementClient.GlobalDNS) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No globalDNS ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		foundGlobalDNS, err := client.GlobalDNS.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("GlobalDNS not found")
+			}
+			return err
+		}
+
+		globalDNS = foundGlobalDNS
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2GlobalDNSDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2GlobalDNSType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GlobalDNS.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("GlobalDNS still exists")
+	}
+	return nil
+}
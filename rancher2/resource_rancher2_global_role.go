@@ -0,0 +1,198 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func resourceRancher2GlobalRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2GlobalRoleCreate,
+		Read:   resourceRancher2GlobalRoleRead,
+		Update: resourceRancher2GlobalRoleUpdate,
+		Delete: resourceRancher2GlobalRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2GlobalRoleImport,
+		},
+
+		Schema: globalRoleFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2GlobalRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	globalRole := expandGlobalRole(d)
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Global Role %s", globalRole.Name)
+
+	newGlobalRole, err := client.GlobalRole.Create(globalRole)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    globalRoleStateRefreshFunc(client, newGlobalRole.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for global role (%s) to be created: %s", newGlobalRole.ID, waitErr)
+	}
+
+	err = flattenGlobalRole(d, newGlobalRole)
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2GlobalRoleRead(d, meta)
+}
+
+func resourceRancher2GlobalRoleRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Global Role ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	globalRole, err := client.GlobalRole.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Global Role ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = flattenGlobalRole(d, globalRole)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceRancher2GlobalRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Global Role ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	globalRole, err := client.GlobalRole.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	update := map[string]interface{}{
+		"name":           d.Get("name").(string),
+		"description":    d.Get("description").(string),
+		"newUserDefault": d.Get("new_user_default").(bool),
+		"rules":          expandPolicyRules(d.Get("rules").([]interface{})),
+		"annotations":    toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":         toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newGlobalRole, err := client.GlobalRole.Update(globalRole, update)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    globalRoleStateRefreshFunc(client, newGlobalRole.ID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for global role (%s) to be updated: %s", newGlobalRole.ID, waitErr)
+	}
+
+	return resourceRancher2GlobalRoleRead(d, meta)
+}
+
+func resourceRancher2GlobalRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Global Role ID %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	globalRole, err := client.GlobalRole.ByID(id)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Global Role ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.GlobalRole.Delete(globalRole)
+	if err != nil {
+		return fmt.Errorf("Error removing Global Role: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for global role (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    globalRoleStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for global role (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// globalRoleStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Global Role.
+func globalRoleStateRefreshFunc(client *managementClient.Client, globalRoleID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.GlobalRole.ByID(globalRoleID)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
@@ -0,0 +1,213 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	norman "github.com/rancher/norman/types"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	testAccRancher2GlobalRoleBindingType   = "rancher2_global_role_binding"
+	testAccRancher2GlobalRoleBindingConfig = `
+resource "rancher2_user" "foo" {
+  name = "Terraform global role binding acceptance test"
+  username = "foo-grb"
+  password = "TestACC123"
+  enabled = true
+}
+resource "rancher2_global_role_binding" "foo" {
+  name = "foo"
+  global_role_id = "user-base"
+  user_id = "${rancher2_user.foo.id}"
+}
+`
+
+	testAccRancher2GlobalRoleBindingUpdateConfig = `
+resource "rancher2_user" "foo" {
+  name = "Terraform global role binding acceptance test"
+  username = "foo-grb"
+  password = "TestACC123"
+  enabled = true
+}
+resource "rancher2_global_role_binding" "foo" {
+  name = "foo"
+  global_role_id = "user"
+  user_id = "${rancher2_user.foo.id}"
+}
+ `
+
+	testAccRancher2GlobalRoleBindingRecreateConfig = `
+resource "rancher2_user" "foo" {
+  name = "Terraform global role binding acceptance test"
+  username = "foo-grb"
+  password = "TestACC123"
+  enabled = true
+}
+resource "rancher2_global_role_binding" "foo" {
+  name = "foo"
+  global_role_id = "user-base"
+  user_id = "${rancher2_user.foo.id}"
+}
+ `
+)
+
+func TestAccRancher2GlobalRoleBinding_basic(t *testing.T) {
+	var globalRoleBinding *GlobalRoleBinding
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2GlobalRoleBindingDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2GlobalRoleBindingConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalRoleBindingExists(testAccRancher2GlobalRoleBindingType+".foo", globalRoleBinding),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleBindingType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleBindingType+".foo", "global_role_id", "user-base"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2GlobalRoleBindingUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalRoleBindingExists(testAccRancher2GlobalRoleBindingType+".foo", globalRoleBinding),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleBindingType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleBindingType+".foo", "global_role_id", "user"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2GlobalRoleBindingRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalRoleBindingExists(testAccRancher2GlobalRoleBindingType+".foo", globalRoleBinding),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleBindingType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleBindingType+".foo", "global_role_id", "user-base"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2GlobalRoleBinding_disappears(t *testing.T) {
+	var globalRoleBinding *GlobalRoleBinding
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2GlobalRoleBindingDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2GlobalRoleBindingConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalRoleBindingExists(testAccRancher2GlobalRoleBindingType+".foo", globalRoleBinding),
+					testAccRancher2GlobalRoleBindingDisappears(globalRoleBinding),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2GlobalRoleBindingDisappears(globalRoleBinding *GlobalRoleBinding) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2GlobalRoleBindingType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ManagementClient()
+			if err != nil {
+				return err
+			}
+
+			globalRoleBinding := &norman.Resource{}
+			err = client.APIBaseClient.ByID(managementClient.GlobalRoleBindingType, rs.Primary.ID, globalRoleBinding)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.APIBaseClient.Delete(globalRoleBinding)
+			if err != nil {
+				return fmt.Errorf("Error removing Global Role Binding: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    globalRoleBindingStateRefreshFunc(client, globalRoleBinding.ID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for global role binding (%s) to be removed: %s", globalRoleBinding.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+func testAccCheckRancher2GlobalRoleBindingExists(n string, globalRoleBinding *GlobalRoleBinding) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No global role binding ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		foundGlobalRoleBinding := &GlobalRoleBinding{}
+		err = client.APIBaseClient.ByID(managementClient.GlobalRoleBindingType, rs.Primary.ID, foundGlobalRoleBinding)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("Global Role Binding not found")
+			}
+			return err
+		}
+
+		globalRoleBinding = foundGlobalRoleBinding
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2GlobalRoleBindingDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2GlobalRoleBindingType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		obj := &GlobalRoleBinding{}
+		err = client.APIBaseClient.ByID(managementClient.GlobalRoleBindingType, rs.Primary.ID, obj)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("Global Role Binding still exists")
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	testAccRancher2GlobalRoleType   = "rancher2_global_role"
+	testAccRancher2GlobalRoleConfig = `
+resource "rancher2_global_role" "foo" {
+  name = "foo"
+  description = "Terraform global role acceptance test"
+  rules {
+    api_groups = ["*"]
+    resources = ["secrets"]
+    verbs = ["create"]
+  }
+}
+`
+
+	testAccRancher2GlobalRoleUpdateConfig = `
+resource "rancher2_global_role" "foo" {
+  name = "foo-updated"
+  description = "Terraform global role acceptance test - updated"
+  new_user_default = true
+  rules {
+    api_groups = ["*"]
+    resources = ["secrets"]
+    verbs = ["create", "list"]
+  }
+}
+ `
+
+	testAccRancher2GlobalRoleRecreateConfig = `
+resource "rancher2_global_role" "foo" {
+  name = "foo"
+  description = "Terraform global role acceptance test"
+  rules {
+    api_groups = ["*"]
+    resources = ["secrets"]
+    verbs = ["create"]
+  }
+}
+ `
+)
+
+func TestAccRancher2GlobalRole_basic(t *testing.T) {
+	var globalRole *managementClient.GlobalRole
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2GlobalRoleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2GlobalRoleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalRoleExists(testAccRancher2GlobalRoleType+".foo", globalRole),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleType+".foo", "description", "Terraform global role acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleType+".foo", "new_user_default", "false"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleType+".foo", "rules.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2GlobalRoleUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalRoleExists(testAccRancher2GlobalRoleType+".foo", globalRole),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleType+".foo", "name", "foo-updated"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleType+".foo", "description", "Terraform global role acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleType+".foo", "new_user_default", "true"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleType+".foo", "rules.0.verbs.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2GlobalRoleRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalRoleExists(testAccRancher2GlobalRoleType+".foo", globalRole),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleType+".foo", "description", "Terraform global role acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleType+".foo", "new_user_default", "false"),
+					resource.TestCheckResourceAttr(testAccRancher2GlobalRoleType+".foo", "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2GlobalRole_disappears(t *testing.T) {
+	var globalRole *managementClient.GlobalRole
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2GlobalRoleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2GlobalRoleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2GlobalRoleExists(testAccRancher2GlobalRoleType+".foo", globalRole),
+					testAccRancher2GlobalRoleDisappears(globalRole),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2GlobalRoleDisappears(globalRole *managementClient.GlobalRole) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2GlobalRoleType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ManagementClient()
+			if err != nil {
+				return err
+			}
+
+			globalRole, err = client.GlobalRole.ByID(rs.Primary.ID)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.GlobalRole.Delete(globalRole)
+			if err != nil {
+				return fmt.Errorf("Error removing Global Role: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    globalRoleStateRefreshFunc(client, globalRole.ID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for global role (%s) to be removed: %s", globalRole.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+func testAccCheckRancher2GlobalRoleExists(n string, globalRole *managementClient.GlobalRole) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No global role ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		foundGlobalRole, err := client.GlobalRole.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("Global Role not found")
+			}
+			return err
+		}
+
+		globalRole = foundGlobalRole
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2GlobalRoleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2GlobalRoleType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GlobalRole.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("Global Role still exists")
+	}
+	return nil
+}
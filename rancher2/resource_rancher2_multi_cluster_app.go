@@ -0,0 +1,286 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func resourceRancher2MultiClusterApp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2MultiClusterAppCreate,
+		Read:   resourceRancher2MultiClusterAppRead,
+		Update: resourceRancher2MultiClusterAppUpdate,
+		Delete: resourceRancher2MultiClusterAppDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2MultiClusterAppImport,
+		},
+
+		Schema: multiClusterAppFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2MultiClusterAppCreate(d *schema.ResourceData, meta interface{}) error {
+	multiClusterApp := expandMultiClusterApp(d)
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Multi Cluster App %s", multiClusterApp.Name)
+
+	newMultiClusterApp, err := client.MultiClusterApp.Create(multiClusterApp)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newMultiClusterApp.ID)
+
+	err = waitForMultiClusterAppActive(client, newMultiClusterApp.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2MultiClusterAppRead(d, meta)
+}
+
+func resourceRancher2MultiClusterAppRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Multi Cluster App ID %s", d.Id())
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	multiClusterApp, err := client.MultiClusterApp.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Multi Cluster App ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	externalID, err := getMultiClusterAppExternalID(client, multiClusterApp.TemplateVersionID)
+	if err != nil {
+		return err
+	}
+
+	return flattenMultiClusterApp(d, multiClusterApp, externalID)
+}
+
+func resourceRancher2MultiClusterAppUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Multi Cluster App ID %s", d.Id())
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	multiClusterApp, err := client.MultiClusterApp.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Adding and removing targets by action, to not redeploy the app on existing targets
+	if d.HasChange("targets") {
+		oldTargets, newTargets := d.GetChange("targets")
+		add, remove := expandMultiClusterAppTargetsUpdate(oldTargets.([]interface{}), newTargets.([]interface{}), d.Get("answers").([]interface{}))
+
+		if len(add.Projects) > 0 {
+			log.Printf("[INFO] Adding projects %v to Multi Cluster App ID %s", add.Projects, d.Id())
+			err = client.MultiClusterApp.ActionAddProjects(multiClusterApp, add)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Adding projects to Multi Cluster App ID %s: %s", d.Id(), err)
+			}
+		}
+
+		if len(remove.Projects) > 0 {
+			log.Printf("[INFO] Removing projects %v from Multi Cluster App ID %s", remove.Projects, d.Id())
+			err = client.MultiClusterApp.ActionRemoveProjects(multiClusterApp, remove)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Removing projects from Multi Cluster App ID %s: %s", d.Id(), err)
+			}
+		}
+
+		err = waitForMultiClusterAppActive(client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+
+		multiClusterApp, err = client.MultiClusterApp.ByID(d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
+	// Updating only changed fields. Answers added with targets are already set
+	expanded := expandMultiClusterApp(d)
+	update := map[string]interface{}{}
+	if expanded.TemplateVersionID != multiClusterApp.TemplateVersionID {
+		update["templateVersionId"] = expanded.TemplateVersionID
+	}
+	if !sameMultiClusterAppAnswers(expanded.Answers, multiClusterApp.Answers) {
+		update["answers"] = expanded.Answers
+	}
+	if d.HasChange("members") {
+		update["members"] = expanded.Members
+	}
+	if d.HasChange("roles") {
+		update["roles"] = expanded.Roles
+	}
+	if d.HasChange("revision_history_limit") {
+		update["revisionHistoryLimit"] = expanded.RevisionHistoryLimit
+	}
+	if d.HasChange("upgrade_strategy") {
+		update["upgradeStrategy"] = expanded.UpgradeStrategy
+	}
+	if d.HasChange("annotations") {
+		update["annotations"] = toMapString(d.Get("annotations").(map[string]interface{}))
+	}
+	if d.HasChange("labels") {
+		update["labels"] = toMapString(d.Get("labels").(map[string]interface{}))
+	}
+
+	if len(update) > 0 {
+		newMultiClusterApp, err := client.MultiClusterApp.Update(multiClusterApp, update)
+		if err != nil {
+			return err
+		}
+
+		err = waitForMultiClusterAppActive(client, newMultiClusterApp.ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceRancher2MultiClusterAppRead(d, meta)
+}
+
+func resourceRancher2MultiClusterAppDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Multi Cluster App ID %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	multiClusterApp, err := client.MultiClusterApp.ByID(id)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Multi Cluster App ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.MultiClusterApp.Delete(multiClusterApp)
+	if err != nil {
+		return fmt.Errorf("Error removing Multi Cluster App: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for multi cluster app (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active", "removing"},
+		Target:     []string{"removed"},
+		Refresh:    multiClusterAppStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for multi cluster app (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForMultiClusterAppActive(client *managementClient.Client, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active", "activating", "deploying", "installing", "updating", "upgrading"},
+		Target:     []string{"active"},
+		Refresh:    multiClusterAppStateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf("[ERROR] waiting for multi cluster app (%s) to be active: %s", id, waitErr)
+	}
+
+	return nil
+}
+
+// getMultiClusterAppExternalID returns the catalog external ID of the multi cluster app template version
+func getMultiClusterAppExternalID(client *managementClient.Client, templateVersionID string) (string, error) {
+	templateVersion, err := client.TemplateVersion.ByID(templateVersionID)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Getting template version %s: %s", templateVersionID, err)
+	}
+
+	return templateVersion.ExternalID, nil
+}
+
+// sameMultiClusterAppAnswers returns true if a and b have the same answers, in any order
+func sameMultiClusterAppAnswers(a, b []managementClient.Answer) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	values := map[string]map[string]string{}
+	for _, answer := range a {
+		values[answer.ClusterID+":"+answer.ProjectID] = answer.Values
+	}
+	for _, answer := range b {
+		v, ok := values[answer.ClusterID+":"+answer.ProjectID]
+		if !ok {
+			return false
+		}
+		if len(v) == 0 && len(answer.Values) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(v, answer.Values) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// multiClusterAppStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Multi Cluster App.
+func multiClusterAppStateRefreshFunc(client *managementClient.Client, multiClusterAppID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.MultiClusterApp.ByID(multiClusterAppID)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Transitioning == "error" {
+			return nil, "", fmt.Errorf("%s", obj.TransitioningMessage)
+		}
+
+		return obj, obj.State, nil
+	}
+}
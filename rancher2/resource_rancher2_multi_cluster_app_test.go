@@ -0,0 +1,263 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	testAccRancher2MultiClusterAppType = "rancher2_multi_cluster_app"
+)
+
+var (
+	testAccRancher2MultiClusterAppProjects       string
+	testAccRancher2MultiClusterAppConfig         string
+	testAccRancher2MultiClusterAppUpdateConfig   string
+	testAccRancher2MultiClusterAppRecreateConfig string
+)
+
+func init() {
+	testAccRancher2MultiClusterAppProjects = `
+resource "rancher2_project" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform multi cluster app acceptance test"
+}
+resource "rancher2_project" "foo2" {
+  name = "foo2"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform multi cluster app acceptance test"
+}
+`
+
+	testAccRancher2MultiClusterAppConfig = testAccRancher2MultiClusterAppProjects + `
+resource "rancher2_multi_cluster_app" "foo" {
+  name = "foo"
+  catalog_name = "library"
+  template_name = "docker-registry"
+  template_version = "1.6.1"
+  targets {
+    project_id = "${rancher2_project.foo.id}"
+  }
+  answers {
+    values = {
+      "ingress_host" = "test.xip.io"
+    }
+  }
+  roles = ["project-member"]
+}
+`
+
+	testAccRancher2MultiClusterAppUpdateConfig = testAccRancher2MultiClusterAppProjects + `
+resource "rancher2_multi_cluster_app" "foo" {
+  name = "foo"
+  catalog_name = "library"
+  template_name = "docker-registry"
+  template_version = "1.6.1"
+  targets {
+    project_id = "${rancher2_project.foo.id}"
+  }
+  targets {
+    project_id = "${rancher2_project.foo2.id}"
+  }
+  answers {
+    values = {
+      "ingress_host" = "test.xip.io"
+    }
+  }
+  answers {
+    project_id = "${rancher2_project.foo2.id}"
+    values = {
+      "ingress_host" = "test2.xip.io"
+    }
+  }
+  roles = ["project-member"]
+}
+ `
+
+	testAccRancher2MultiClusterAppRecreateConfig = testAccRancher2MultiClusterAppProjects + `
+resource "rancher2_multi_cluster_app" "foo" {
+  name = "foo"
+  catalog_name = "library"
+  template_name = "docker-registry"
+  template_version = "1.8.1"
+  targets {
+    project_id = "${rancher2_project.foo.id}"
+  }
+  answers {
+    values = {
+      "ingress_host" = "test.xip.io"
+    }
+  }
+  roles = ["project-member"]
+}
+ `
+}
+
+func TestAccRancher2MultiClusterApp_basic(t *testing.T) {
+	var multiClusterApp *managementClient.MultiClusterApp
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2MultiClusterAppDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2MultiClusterAppConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2MultiClusterAppExists(testAccRancher2MultiClusterAppType+".foo", multiClusterApp),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "template_version", "1.6.1"),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "template_version_id", "cattle-global-data:library-docker-registry-1.6.1"),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "targets.#", "1"),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "answers.#", "1"),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "answers.0.values.ingress_host", "test.xip.io"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2MultiClusterAppUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2MultiClusterAppExists(testAccRancher2MultiClusterAppType+".foo", multiClusterApp),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "template_version", "1.6.1"),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "targets.#", "2"),
+					resource.TestCheckResourceAttrPair(testAccRancher2MultiClusterAppType+".foo", "targets.1.project_id", "rancher2_project.foo2", "id"),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "answers.#", "2"),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "answers.1.values.ingress_host", "test2.xip.io"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2MultiClusterAppRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2MultiClusterAppExists(testAccRancher2MultiClusterAppType+".foo", multiClusterApp),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "template_version", "1.8.1"),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "targets.#", "1"),
+					resource.TestCheckResourceAttr(testAccRancher2MultiClusterAppType+".foo", "answers.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2MultiClusterApp_disappears(t *testing.T) {
+	var multiClusterApp *managementClient.MultiClusterApp
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2MultiClusterAppDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2MultiClusterAppConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2MultiClusterAppExists(testAccRancher2MultiClusterAppType+".foo", multiClusterApp),
+					testAccRancher2MultiClusterAppDisappears(multiClusterApp),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2MultiClusterAppDisappears(multiClusterApp *managementClient.MultiClusterApp) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2MultiClusterAppType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ManagementClient()
+			if err != nil {
+				return err
+			}
+
+			multiClusterApp, err = client.MultiClusterApp.ByID(rs.Primary.ID)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.MultiClusterApp.Delete(multiClusterApp)
+			if err != nil {
+				return fmt.Errorf("Error removing Multi Cluster App: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active", "removing"},
+				Target:     []string{"removed"},
+				Refresh:    multiClusterAppStateRefreshFunc(client, multiClusterApp.ID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for multi cluster app (%s) to be removed: %s", multiClusterApp.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+func testAccCheckRancher2MultiClusterAppExists(n string, multiClusterApp *managementClient.MultiClusterApp) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No multi cluster app ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		foundMultiClusterApp, err := client.MultiClusterApp.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("Multi Cluster App not found")
+			}
+			return err
+		}
+
+		multiClusterApp = foundMultiClusterApp
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2MultiClusterAppDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2MultiClusterAppType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.MultiClusterApp.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("Multi Cluster App still exists")
+	}
+	return nil
+}
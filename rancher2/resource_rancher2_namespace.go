@@ -10,6 +10,10 @@ import (
 	clusterClient "github.com/rancher/types/client/cluster/v3"
 )
 
+const (
+	namespaceProjectIDAnnotation = "field.cattle.io/projectId"
+)
+
 func resourceRancher2Namespace() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRancher2NamespaceCreate,
@@ -134,15 +138,43 @@ func resourceRancher2NamespaceUpdate(d *schema.ResourceData, meta interface{}) e
 		if err != nil {
 			return err
 		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"moving"},
+			Target:     []string{"moved"},
+			Refresh:    namespaceMoveRefreshFunc(client, ns.ID, projectID),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      1 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		_, waitErr := stateConf.WaitForState()
+		if waitErr != nil {
+			return fmt.Errorf(
+				"[ERROR] waiting for namespace (%s) to be moved: %s", ns.ID, waitErr)
+		}
+
+		ns, err = client.Namespace.ByID(d.Id())
+		if err != nil {
+			return err
+		}
 	}
 
 	resourceQuota := expandNamespaceResourceQuota(d.Get("resource_quota").([]interface{}))
 
+	// Project annotation is managed by rancher, keeping it to not revert namespace move
+	annotations := toMapString(d.Get("annotations").(map[string]interface{}))
+	if v, ok := ns.Annotations[namespaceProjectIDAnnotation]; ok {
+		annotations[namespaceProjectIDAnnotation] = v
+	} else {
+		delete(annotations, namespaceProjectIDAnnotation)
+	}
+
 	update := map[string]interface{}{
-		"description":   d.Get("description").(string),
-		"resourceQuota": resourceQuota,
-		"annotations":   toMapString(d.Get("annotations").(map[string]interface{})),
-		"labels":        toMapString(d.Get("labels").(map[string]interface{})),
+		"description":                   d.Get("description").(string),
+		"containerDefaultResourceLimit": expandNamespaceContainerResourceLimit(d.Get("container_resource_limit").([]interface{})),
+		"resourceQuota":                 resourceQuota,
+		"annotations":                   annotations,
+		"labels":                        toMapString(d.Get("labels").(map[string]interface{})),
 	}
 
 	newNs, err := client.Namespace.Update(ns, update)
@@ -232,3 +264,19 @@ func namespaceStateRefreshFunc(client *clusterClient.Client, nsID string) resour
 		return obj, obj.State, nil
 	}
 }
+
+// namespaceMoveRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Namespace move to projectID.
+func namespaceMoveRefreshFunc(client *clusterClient.Client, nsID, projectID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.Namespace.ByID(nsID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if obj.Annotations[namespaceProjectIDAnnotation] != projectID {
+			return obj, "moving", nil
+		}
+
+		return obj, "moved", nil
+	}
+}
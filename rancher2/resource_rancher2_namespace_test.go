@@ -19,6 +19,8 @@ var (
 	testAccRancher2NamespaceConfig         string
 	testAccRancher2NamespaceUpdateConfig   string
 	testAccRancher2NamespaceRecreateConfig string
+	testAccRancher2NamespaceMoveConfig     string
+	testAccRancher2NamespaceMoveOutConfig  string
 )
 
 func init() {
@@ -47,6 +49,12 @@ resource "rancher2_namespace" "foo" {
   name = "foo"
   description = "Terraform namespace acceptance test"
   project_id = "${rancher2_project.foo.id}"
+  container_resource_limit {
+    limits_cpu = "20m"
+    limits_memory = "20Mi"
+    requests_cpu = "1m"
+    requests_memory = "1Mi"
+  }
   resource_quota {
     limit {
       limits_cpu = "100m"
@@ -62,6 +70,12 @@ resource "rancher2_namespace" "foo" {
   name = "foo"
   description = "Terraform namespace acceptance test - updated"
   project_id = "${rancher2_project.foo.id}"
+  container_resource_limit {
+    limits_cpu = "30m"
+    limits_memory = "20Mi"
+    requests_cpu = "1m"
+    requests_memory = "1Mi"
+  }
   resource_quota {
     limit {
       limits_cpu = "100m"
@@ -77,6 +91,59 @@ resource "rancher2_namespace" "foo" {
   name = "foo"
   description = "Terraform namespace acceptance test"
   project_id = "${rancher2_project.foo.id}"
+  container_resource_limit {
+    limits_cpu = "20m"
+    limits_memory = "20Mi"
+    requests_cpu = "1m"
+    requests_memory = "1Mi"
+  }
+  resource_quota {
+    limit {
+      limits_cpu = "100m"
+      limits_memory = "100Mi"
+      requests_storage = "1Gi"
+    }
+  }
+}
+ `
+
+	testAccRancher2NamespaceMoveConfig = testAccRancher2NamespaceProject + `
+resource "rancher2_project" "foo2" {
+  name = "foo2"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform namespace acceptance test"
+  resource_quota {
+    project_limit {
+      limits_cpu = "2000m"
+      limits_memory = "2000Mi"
+      requests_storage = "2Gi"
+    }
+    namespace_default_limit {
+      limits_cpu = "500m"
+      limits_memory = "500Mi"
+      requests_storage = "1Gi"
+    }
+  }
+}
+resource "rancher2_namespace" "foo" {
+  name = "foo"
+  description = "Terraform namespace acceptance test"
+  project_id = "${rancher2_project.foo2.id}"
+  resource_quota {
+    limit {
+      limits_cpu = "100m"
+      limits_memory = "100Mi"
+      requests_storage = "1Gi"
+    }
+  }
+}
+ `
+
+	testAccRancher2NamespaceMoveOutConfig = testAccRancher2NamespaceProject + `
+resource "rancher2_namespace" "foo" {
+  name = "foo"
+  description = "Terraform namespace acceptance test"
+  project_id = "` + testAccRancher2ClusterID + `"
   resource_quota {
     limit {
       limits_cpu = "100m"
@@ -101,6 +168,7 @@ func TestAccRancher2Namespace_basic(t *testing.T) {
 					testAccCheckRancher2NamespaceExists(testAccRancher2NamespaceType+".foo", ns),
 					resource.TestCheckResourceAttr(testAccRancher2NamespaceType+".foo", "name", "foo"),
 					resource.TestCheckResourceAttr(testAccRancher2NamespaceType+".foo", "description", "Terraform namespace acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2NamespaceType+".foo", "container_resource_limit.0.limits_cpu", "20m"),
 				),
 			},
 			resource.TestStep{
@@ -109,6 +177,7 @@ func TestAccRancher2Namespace_basic(t *testing.T) {
 					testAccCheckRancher2NamespaceExists(testAccRancher2NamespaceType+".foo", ns),
 					resource.TestCheckResourceAttr(testAccRancher2NamespaceType+".foo", "name", "foo"),
 					resource.TestCheckResourceAttr(testAccRancher2NamespaceType+".foo", "description", "Terraform namespace acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2NamespaceType+".foo", "container_resource_limit.0.limits_cpu", "30m"),
 				),
 			},
 			resource.TestStep{
@@ -117,6 +186,50 @@ func TestAccRancher2Namespace_basic(t *testing.T) {
 					testAccCheckRancher2NamespaceExists(testAccRancher2NamespaceType+".foo", ns),
 					resource.TestCheckResourceAttr(testAccRancher2NamespaceType+".foo", "name", "foo"),
 					resource.TestCheckResourceAttr(testAccRancher2NamespaceType+".foo", "description", "Terraform namespace acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2NamespaceType+".foo", "container_resource_limit.0.limits_cpu", "20m"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2Namespace_move(t *testing.T) {
+	var ns *clusterClient.Namespace
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2NamespaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2NamespaceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2NamespaceExists(testAccRancher2NamespaceType+".foo", ns),
+					resource.TestCheckResourceAttrPair(testAccRancher2NamespaceType+".foo", "project_id", "rancher2_project.foo", "id"),
+					testAccCheckRancher2NamespaceProject(testAccRancher2NamespaceType+".foo"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2NamespaceMoveConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2NamespaceExists(testAccRancher2NamespaceType+".foo", ns),
+					resource.TestCheckResourceAttrPair(testAccRancher2NamespaceType+".foo", "project_id", "rancher2_project.foo2", "id"),
+					testAccCheckRancher2NamespaceProject(testAccRancher2NamespaceType+".foo"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2NamespaceMoveOutConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2NamespaceExists(testAccRancher2NamespaceType+".foo", ns),
+					resource.TestCheckResourceAttr(testAccRancher2NamespaceType+".foo", "project_id", testAccRancher2ClusterID),
+					testAccCheckRancher2NamespaceProject(testAccRancher2NamespaceType+".foo"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2NamespaceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2NamespaceExists(testAccRancher2NamespaceType+".foo", ns),
+					resource.TestCheckResourceAttrPair(testAccRancher2NamespaceType+".foo", "project_id", "rancher2_project.foo", "id"),
+					testAccCheckRancher2NamespaceProject(testAccRancher2NamespaceType+".foo"),
 				),
 			},
 		},
@@ -148,10 +261,7 @@ func testAccRancher2NamespaceDisappears(ns *clusterClient.Namespace) resource.Te
 			if rs.Type != testAccRancher2NamespaceType {
 				continue
 			}
-			clusterID, err := clusterIDFromProjectID(rs.Primary.Attributes["project_id"])
-			if err != nil {
-				return err
-			}
+			clusterID, _ := splitProjectID(rs.Primary.Attributes["project_id"])
 			client, err := testAccProvider.Meta().(*Config).ClusterClient(clusterID)
 			if err != nil {
 				return err
@@ -202,11 +312,7 @@ func testAccCheckRancher2NamespaceExists(n string, ns *clusterClient.Namespace)
 			return fmt.Errorf("No namespace ID is set")
 		}
 
-		clusterID, err := clusterIDFromProjectID(rs.Primary.Attributes["project_id"])
-		if err != nil {
-			return err
-		}
-
+		clusterID, _ := splitProjectID(rs.Primary.Attributes["project_id"])
 		client, err := testAccProvider.Meta().(*Config).ClusterClient(clusterID)
 		if err != nil {
 			return err
@@ -226,16 +332,40 @@ func testAccCheckRancher2NamespaceExists(n string, ns *clusterClient.Namespace)
 	}
 }
 
+func testAccCheckRancher2NamespaceProject(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		clusterID, projectID := splitProjectID(rs.Primary.Attributes["project_id"])
+		client, err := testAccProvider.Meta().(*Config).ClusterClient(clusterID)
+		if err != nil {
+			return err
+		}
+
+		foundNs, err := client.Namespace.ByID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if foundNs.Annotations[namespaceProjectIDAnnotation] != projectID {
+			return fmt.Errorf("Namespace project annotation %q doesn't match project ID %q", foundNs.Annotations[namespaceProjectIDAnnotation], projectID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckRancher2NamespaceDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != testAccRancher2NamespaceType {
 			continue
 		}
 
-		clusterID, err := clusterIDFromProjectID(rs.Primary.Attributes["project_id"])
-		if err != nil {
-			return err
-		}
+		clusterID, _ := splitProjectID(rs.Primary.Attributes["project_id"])
 		client, err := testAccProvider.Meta().(*Config).ClusterClient(clusterID)
 		if err != nil {
 			return err
@@ -0,0 +1,220 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func resourceRancher2Notifier() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2NotifierCreate,
+		Read:   resourceRancher2NotifierRead,
+		Update: resourceRancher2NotifierUpdate,
+		Delete: resourceRancher2NotifierDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2NotifierImport,
+		},
+
+		Schema: notifierFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2NotifierCreate(d *schema.ResourceData, meta interface{}) error {
+	notifier := expandNotifier(d)
+
+	if notifier.PagerdutyConfig == nil && notifier.SlackConfig == nil && notifier.SMTPConfig == nil && notifier.WebhookConfig == nil && notifier.WechatConfig == nil {
+		return fmt.Errorf("[ERROR] Creating Notifier: pagerduty_config, slack_config, smtp_config, webhook_config or wechat_config should be provided")
+	}
+
+	err := meta.(*Config).ClusterExist(notifier.ClusterID)
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Notifier %s on Cluster ID %s", notifier.Name, notifier.ClusterID)
+
+	newNotifier, err := client.Notifier.Create(notifier)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"activating"},
+		Target:     []string{"active"},
+		Refresh:    notifierStateRefreshFunc(client, newNotifier.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for notifier (%s) to be created: %s", newNotifier.ID, waitErr)
+	}
+
+	d.SetId(newNotifier.ID)
+
+	if d.Get("send_test").(bool) {
+		log.Printf("[INFO] Sending test notification with Notifier ID %s", d.Id())
+
+		notification := &managementClient.Notification{
+			PagerdutyConfig: notifier.PagerdutyConfig,
+			SlackConfig:     notifier.SlackConfig,
+			SMTPConfig:      notifier.SMTPConfig,
+			WebhookConfig:   notifier.WebhookConfig,
+			WechatConfig:    notifier.WechatConfig,
+		}
+		err = client.Notifier.ActionSend(newNotifier, notification)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Sending test notification with Notifier ID %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceRancher2NotifierRead(d, meta)
+}
+
+func resourceRancher2NotifierRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Notifier ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	notifier, err := client.Notifier.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Notifier ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenNotifier(d, notifier)
+}
+
+func resourceRancher2NotifierUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Notifier ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	notifier, err := client.Notifier.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Unset configs are sent as null, to allow changing the notifier type
+	newConfig := expandNotifier(d)
+	update := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"pagerdutyConfig": newConfig.PagerdutyConfig,
+		"slackConfig":     newConfig.SlackConfig,
+		"smtpConfig":      newConfig.SMTPConfig,
+		"webhookConfig":   newConfig.WebhookConfig,
+		"wechatConfig":    newConfig.WechatConfig,
+		"annotations":     toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":          toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newNotifier, err := client.Notifier.Update(notifier, update)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    notifierStateRefreshFunc(client, newNotifier.ID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for notifier (%s) to be updated: %s", newNotifier.ID, waitErr)
+	}
+
+	return resourceRancher2NotifierRead(d, meta)
+}
+
+func resourceRancher2NotifierDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Notifier ID %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	notifier, err := client.Notifier.ByID(id)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Notifier ID %s not found.", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.Notifier.Delete(notifier)
+	if err != nil {
+		return fmt.Errorf("Error removing Notifier: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for notifier (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    notifierStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for notifier (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// notifierStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Notifier.
+func notifierStateRefreshFunc(client *managementClient.Client, notifierID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.Notifier.ByID(notifierID)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
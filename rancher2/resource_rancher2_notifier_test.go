@@ -0,0 +1,222 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	testAccRancher2NotifierType = "rancher2_notifier"
+)
+
+var (
+	testAccRancher2NotifierConfig         string
+	testAccRancher2NotifierUpdateConfig   string
+	testAccRancher2NotifierRecreateConfig string
+)
+
+func init() {
+	testAccRancher2NotifierConfig = `
+resource "rancher2_notifier" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform notifier acceptance test"
+  slack_config {
+    default_recipient = "#foo"
+    url = "http://foo.com:8080"
+  }
+}
+`
+
+	testAccRancher2NotifierUpdateConfig = `
+resource "rancher2_notifier" "foo" {
+  name = "foo-updated"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform notifier acceptance test - updated"
+  smtp_config {
+    default_recipient = "foo@foo.com"
+    host = "smtp.foo.com"
+    port = 587
+    sender = "rancher@foo.com"
+    username = "user"
+    password = "pass"
+  }
+}
+ `
+
+	testAccRancher2NotifierRecreateConfig = `
+resource "rancher2_notifier" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform notifier acceptance test"
+  slack_config {
+    default_recipient = "#foo"
+    url = "http://foo.com:8080"
+  }
+}
+ `
+}
+
+func TestAccRancher2Notifier_basic(t *testing.T) {
+	var notifier *managementClient.Notifier
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2NotifierDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2NotifierConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2NotifierExists(testAccRancher2NotifierType+".foo", notifier),
+					resource.TestCheckResourceAttr(testAccRancher2NotifierType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2NotifierType+".foo", "description", "Terraform notifier acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2NotifierType+".foo", "slack_config.0.default_recipient", "#foo"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2NotifierUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2NotifierExists(testAccRancher2NotifierType+".foo", notifier),
+					resource.TestCheckResourceAttr(testAccRancher2NotifierType+".foo", "name", "foo-updated"),
+					resource.TestCheckResourceAttr(testAccRancher2NotifierType+".foo", "description", "Terraform notifier acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2NotifierType+".foo", "slack_config.#", "0"),
+					resource.TestCheckResourceAttr(testAccRancher2NotifierType+".foo", "smtp_config.0.host", "smtp.foo.com"),
+					resource.TestCheckResourceAttr(testAccRancher2NotifierType+".foo", "smtp_config.0.password", "pass"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2NotifierRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2NotifierExists(testAccRancher2NotifierType+".foo", notifier),
+					resource.TestCheckResourceAttr(testAccRancher2NotifierType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2NotifierType+".foo", "description", "Terraform notifier acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2NotifierType+".foo", "smtp_config.#", "0"),
+					resource.TestCheckResourceAttr(testAccRancher2NotifierType+".foo", "slack_config.0.default_recipient", "#foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2Notifier_disappears(t *testing.T) {
+	var notifier *managementClient.Notifier
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2NotifierDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2NotifierConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2NotifierExists(testAccRancher2NotifierType+".foo", notifier),
+					testAccRancher2NotifierDisappears(notifier),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2NotifierDisappears(notifier *managementClient.Notifier) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2NotifierType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ManagementClient()
+			if err != nil {
+				return err
+			}
+
+			notifier, err = client.Notifier.ByID(rs.Primary.ID)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.Notifier.Delete(notifier)
+			if err != nil {
+				return fmt.Errorf("Error removing Notifier: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    notifierStateRefreshFunc(client, notifier.ID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for notifier (%s) to be removed: %s", notifier.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+func testAccCheckRancher2NotifierExists(n string, notifier *managementClient.Notifier) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No notifier ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		foundNotifier, err := client.Notifier.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("Notifier not found")
+			}
+			return err
+		}
+
+		notifier = foundNotifier
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2NotifierDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2NotifierType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Notifier.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("Notifier still exists")
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func resourceRancher2PodSecurityPolicyTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2PodSecurityPolicyTemplateCreate,
+		Read:   resourceRancher2PodSecurityPolicyTemplateRead,
+		Update: resourceRancher2PodSecurityPolicyTemplateUpdate,
+		Delete: resourceRancher2PodSecurityPolicyTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2PodSecurityPolicyTemplateImport,
+		},
+
+		Schema: podSecurityPolicyTemplateFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2PodSecurityPolicyTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	podSecurityPolicyTemplate := expandPodSecurityPolicyTemplate(d)
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Pod Security Policy Template %s", podSecurityPolicyTemplate.Name)
+
+	newPodSecurityPolicyTemplate, err := client.PodSecurityPolicyTemplate.Create(podSecurityPolicyTemplate)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"activating"},
+		Target:     []string{"active"},
+		Refresh:    podSecurityPolicyTemplateStateRefreshFunc(client, newPodSecurityPolicyTemplate.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for pod security policy template (%s) to be created: %s", newPodSecurityPolicyTemplate.ID, waitErr)
+	}
+
+	d.SetId(newPodSecurityPolicyTemplate.ID)
+
+	return resourceRancher2PodSecurityPolicyTemplateRead(d, meta)
+}
+
+func resourceRancher2PodSecurityPolicyTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Pod Security Policy Template ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	podSecurityPolicyTemplate, err := client.PodSecurityPolicyTemplate.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Pod Security Policy Template ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenPodSecurityPolicyTemplate(d, podSecurityPolicyTemplate)
+}
+
+func resourceRancher2PodSecurityPolicyTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Pod Security Policy Template ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	podSecurityPolicyTemplate, err := client.PodSecurityPolicyTemplate.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	newSpec := expandPodSecurityPolicyTemplate(d)
+	update := map[string]interface{}{
+		"description":                     newSpec.Description,
+		"allowPrivilegeEscalation":        newSpec.AllowPrivilegeEscalation,
+		"allowedCapabilities":             newSpec.AllowedCapabilities,
+		"allowedFlexVolumes":              newSpec.AllowedFlexVolumes,
+		"allowedHostPaths":                newSpec.AllowedHostPaths,
+		"allowedProcMountTypes":           newSpec.AllowedProcMountTypes,
+		"allowedUnsafeSysctls":            newSpec.AllowedUnsafeSysctls,
+		"defaultAddCapabilities":          newSpec.DefaultAddCapabilities,
+		"defaultAllowPrivilegeEscalation": newSpec.DefaultAllowPrivilegeEscalation,
+		"forbiddenSysctls":                newSpec.ForbiddenSysctls,
+		"fsGroup":                         newSpec.FSGroup,
+		"hostIPC":                         newSpec.HostIPC,
+		"hostNetwork":                     newSpec.HostNetwork,
+		"hostPID":                         newSpec.HostPID,
+		"hostPorts":                       newSpec.HostPorts,
+		"privileged":                      newSpec.Privileged,
+		"readOnlyRootFilesystem":          newSpec.ReadOnlyRootFilesystem,
+		"requiredDropCapabilities":        newSpec.RequiredDropCapabilities,
+		"runAsUser":                       newSpec.RunAsUser,
+		"seLinux":                         newSpec.SELinux,
+		"supplementalGroups":              newSpec.SupplementalGroups,
+		"volumes":                         newSpec.Volumes,
+		"annotations":                     toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":                          toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newPodSecurityPolicyTemplate, err := client.PodSecurityPolicyTemplate.Update(podSecurityPolicyTemplate, update)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    podSecurityPolicyTemplateStateRefreshFunc(client, newPodSecurityPolicyTemplate.ID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for pod security policy template (%s) to be updated: %s", newPodSecurityPolicyTemplate.ID, waitErr)
+	}
+
+	return resourceRancher2PodSecurityPolicyTemplateRead(d, meta)
+}
+
+func resourceRancher2PodSecurityPolicyTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Pod Security Policy Template ID %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	podSecurityPolicyTemplate, err := client.PodSecurityPolicyTemplate.ByID(id)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Pod Security Policy Template ID %s not found.", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.PodSecurityPolicyTemplate.Delete(podSecurityPolicyTemplate)
+	if err != nil {
+		return fmt.Errorf("Error removing Pod Security Policy Template: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for pod security policy template (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    podSecurityPolicyTemplateStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for pod security policy template (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// podSecurityPolicyTemplateStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Pod Security Policy Template.
+func podSecurityPolicyTemplateStateRefreshFunc(client *managementClient.Client, podSecurityPolicyTemplateID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.PodSecurityPolicyTemplate.ByID(podSecurityPolicyTemplateID)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
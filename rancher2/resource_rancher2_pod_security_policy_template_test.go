@@ -0,0 +1,289 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	testAccRancher2PodSecurityPolicyTemplateType = "rancher2_pod_security_policy_template"
+)
+
+var (
+	testAccRancher2PodSecurityPolicyTemplateConfig         string
+	testAccRancher2PodSecurityPolicyTemplateUpdateConfig   string
+	testAccRancher2PodSecurityPolicyTemplateRecreateConfig string
+)
+
+func init() {
+	testAccRancher2PodSecurityPolicyTemplateConfig = `
+resource "rancher2_pod_security_policy_template" "foo" {
+  name = "foo"
+  description = "Terraform PodSecurityPolicyTemplate acceptance test"
+  allow_privilege_escalation = false
+  allowed_capabilities = ["NET_BIND_SERVICE"]
+  allowed_host_path {
+    path_prefix = "/var/log"
+    read_only = true
+  }
+  fs_group {
+    rule = "MustRunAs"
+    range {
+      min = 1
+      max = 65535
+    }
+  }
+  required_drop_capabilities = ["ALL"]
+  run_as_user {
+    rule = "MustRunAsNonRoot"
+  }
+  se_linux {
+    rule = "RunAsAny"
+  }
+  supplemental_group {
+    rule = "MustRunAs"
+    range {
+      min = 1
+      max = 65535
+    }
+  }
+  volumes = ["configMap", "emptyDir", "projected", "secret", "downwardAPI", "persistentVolumeClaim"]
+}
+`
+
+	testAccRancher2PodSecurityPolicyTemplateUpdateConfig = `
+resource "rancher2_pod_security_policy_template" "foo" {
+  name = "foo"
+  description = "Terraform PodSecurityPolicyTemplate acceptance test - updated"
+  allow_privilege_escalation = true
+  host_network = true
+  host_port {
+    min = 80
+    max = 8080
+  }
+  fs_group {
+    rule = "RunAsAny"
+  }
+  read_only_root_filesystem = true
+  run_as_user {
+    rule = "RunAsAny"
+  }
+  se_linux {
+    rule = "MustRunAs"
+    se_linux_option {
+      level = "s0:c123,c456"
+    }
+  }
+  supplemental_group {
+    rule = "RunAsAny"
+  }
+  volumes = ["*"]
+}
+ `
+
+	testAccRancher2PodSecurityPolicyTemplateRecreateConfig = `
+resource "rancher2_pod_security_policy_template" "foo" {
+  name = "foo"
+  description = "Terraform PodSecurityPolicyTemplate acceptance test"
+  allow_privilege_escalation = false
+  allowed_capabilities = ["NET_BIND_SERVICE"]
+  allowed_host_path {
+    path_prefix = "/var/log"
+    read_only = true
+  }
+  fs_group {
+    rule = "MustRunAs"
+    range {
+      min = 1
+      max = 65535
+    }
+  }
+  required_drop_capabilities = ["ALL"]
+  run_as_user {
+    rule = "MustRunAsNonRoot"
+  }
+  se_linux {
+    rule = "RunAsAny"
+  }
+  supplemental_group {
+    rule = "MustRunAs"
+    range {
+      min = 1
+      max = 65535
+    }
+  }
+  volumes = ["configMap", "emptyDir", "projected", "secret", "downwardAPI", "persistentVolumeClaim"]
+}
+ `
+}
+
+func TestAccRancher2PodSecurityPolicyTemplate_basic(t *testing.T) {
+	var podSecurityPolicyTemplate *managementClient.PodSecurityPolicyTemplate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2PodSecurityPolicyTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2PodSecurityPolicyTemplateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2PodSecurityPolicyTemplateExists(testAccRancher2PodSecurityPolicyTemplateType+".foo", podSecurityPolicyTemplate),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "description", "Terraform PodSecurityPolicyTemplate acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "allow_privilege_escalation", "false"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "allowed_host_path.0.path_prefix", "/var/log"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "fs_group.0.range.0.max", "65535"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "run_as_user.0.rule", "MustRunAsNonRoot"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "volumes.#", "6"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2PodSecurityPolicyTemplateUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2PodSecurityPolicyTemplateExists(testAccRancher2PodSecurityPolicyTemplateType+".foo", podSecurityPolicyTemplate),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "description", "Terraform PodSecurityPolicyTemplate acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "allow_privilege_escalation", "true"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "allowed_host_path.#", "0"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "host_port.0.max", "8080"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "se_linux.0.se_linux_option.0.level", "s0:c123,c456"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "volumes.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2PodSecurityPolicyTemplateRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2PodSecurityPolicyTemplateExists(testAccRancher2PodSecurityPolicyTemplateType+".foo", podSecurityPolicyTemplate),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "description", "Terraform PodSecurityPolicyTemplate acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "host_port.#", "0"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "fs_group.0.rule", "MustRunAs"),
+					resource.TestCheckResourceAttr(testAccRancher2PodSecurityPolicyTemplateType+".foo", "volumes.#", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2PodSecurityPolicyTemplate_disappears(t *testing.T) {
+	var podSecurityPolicyTemplate *managementClient.PodSecurityPolicyTemplate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2PodSecurityPolicyTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2PodSecurityPolicyTemplateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2PodSecurityPolicyTemplateExists(testAccRancher2PodSecurityPolicyTemplateType+".foo", podSecurityPolicyTemplate),
+					testAccRancher2PodSecurityPolicyTemplateDisappears(podSecurityPolicyTemplate),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2PodSecurityPolicyTemplateDisappears(podSecurityPolicyTemplate *managementClient.PodSecurityPolicyTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2PodSecurityPolicyTemplateType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ManagementClient()
+			if err != nil {
+				return err
+			}
+
+			podSecurityPolicyTemplate, err = client.PodSecurityPolicyTemplate.ByID(rs.Primary.ID)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.PodSecurityPolicyTemplate.Delete(podSecurityPolicyTemplate)
+			if err != nil {
+				return fmt.Errorf("Error removing Pod Security Policy Template: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    podSecurityPolicyTemplateStateRefreshFunc(client, podSecurityPolicyTemplate.ID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for podSecurityPolicyTemplate (%s) to be removed: %s", podSecurityPolicyTemplate.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+func testAccCheckRancher2PodSecurityPolicyTemplateExists(n string, podSecurityPolicyTemplate *managementClient.PodSecurityPolicyTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No podSecurityPolicyTemplate ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		foundPodSecurityPolicyTemplate, err := client.PodSecurityPolicyTemplate.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("PodSecurityPolicyTemplate not found")
+			}
+			return err
+		}
+
+		podSecurityPolicyTemplate = foundPodSecurityPolicyTemplate
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2PodSecurityPolicyTemplateDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2PodSecurityPolicyTemplateType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.PodSecurityPolicyTemplate.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("PodSecurityPolicyTemplate still exists")
+	}
+	return nil
+}
@@ -66,6 +66,13 @@ func resourceRancher2ProjectCreate(d *schema.ResourceData, meta interface{}) err
 			"[ERROR] waiting for project (%s) to be created: %s", newProject.ID, waitErr)
 	}
 
+	if pspID, ok := d.Get("pod_security_policy_template_id").(string); ok && len(pspID) > 0 {
+		err = setProjectPodSecurityPolicyTemplate(client, newProject.ID, pspID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
 	err = flattenProject(d, newProject)
 	if err != nil {
 		return err
@@ -116,6 +123,7 @@ func resourceRancher2ProjectUpdate(d *schema.ResourceData, meta interface{}) err
 	update := map[string]interface{}{
 		"name":                          d.Get("name").(string),
 		"description":                   d.Get("description").(string),
+		"containerDefaultResourceLimit": expandProjectContainerResourceLimit(d.Get("container_resource_limit").([]interface{})),
 		"namespaceDefaultResourceQuota": nsResourceQuota,
 		"resourceQuota":                 resourceQuota,
 		"annotations":                   toMapString(d.Get("annotations").(map[string]interface{})),
@@ -141,6 +149,13 @@ func resourceRancher2ProjectUpdate(d *schema.ResourceData, meta interface{}) err
 			"[ERROR] waiting for project (%s) to be updated: %s", newProject.ID, waitErr)
 	}
 
+	if d.HasChange("pod_security_policy_template_id") {
+		err = setProjectPodSecurityPolicyTemplate(client, newProject.ID, d.Get("pod_security_policy_template_id").(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceRancher2ProjectRead(d, meta)
 }
 
@@ -188,6 +203,41 @@ func resourceRancher2ProjectDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+// setProjectPodSecurityPolicyTemplate calls setpodsecuritypolicytemplate action on project. Empty pspID removes the binding
+func setProjectPodSecurityPolicyTemplate(client *managementClient.Client, projectID, pspID string, timeout time.Duration) error {
+	project, err := client.Project.ByID(projectID)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Setting Pod Security Policy Template %s on Project ID %s", pspID, projectID)
+
+	input := &managementClient.SetPodSecurityPolicyTemplateInput{
+		PodSecurityPolicyTemplateName: pspID,
+	}
+
+	_, err = client.Project.ActionSetpodsecuritypolicytemplate(project, input)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Setting Pod Security Policy Template on Project ID %s: %s", projectID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    projectStateRefreshFunc(client, projectID),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for project (%s) pod security policy template to be set: %s", projectID, waitErr)
+	}
+
+	return nil
+}
+
 // projectStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Project.
 func projectStateRefreshFunc(client *managementClient.Client, projectID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
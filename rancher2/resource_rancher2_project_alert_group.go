@@ -0,0 +1,232 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func resourceRancher2ProjectAlertGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2ProjectAlertGroupCreate,
+		Read:   resourceRancher2ProjectAlertGroupRead,
+		Update: resourceRancher2ProjectAlertGroupUpdate,
+		Delete: resourceRancher2ProjectAlertGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2ProjectAlertGroupImport,
+		},
+
+		Schema: projectAlertGroupFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2ProjectAlertGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	projectAlertGroup := expandProjectAlertGroup(d)
+
+	err := meta.(*Config).ProjectExist(projectAlertGroup.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	err = checkProjectAlertGroupRecipients(projectAlertGroup.ProjectID, projectAlertGroup.Recipients)
+	if err != nil {
+		return err
+	}
+
+	err = meta.(*Config).SetAlertRecipientsType(projectAlertGroup.Recipients)
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Project Alert Group %s", projectAlertGroup.Name)
+
+	newProjectAlertGroup, err := client.ProjectAlertGroup.Create(projectAlertGroup)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"activating"},
+		Target:     []string{"active"},
+		Refresh:    projectAlertGroupStateRefreshFunc(client, newProjectAlertGroup.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for project alert group (%s) to be created: %s", newProjectAlertGroup.ID, waitErr)
+	}
+
+	d.SetId(newProjectAlertGroup.ID)
+
+	return resourceRancher2ProjectAlertGroupRead(d, meta)
+}
+
+func resourceRancher2ProjectAlertGroupRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Project Alert Group ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	projectAlertGroup, err := client.ProjectAlertGroup.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Project Alert Group ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenProjectAlertGroup(d, projectAlertGroup)
+}
+
+func resourceRancher2ProjectAlertGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Project Alert Group ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	projectAlertGroup, err := client.ProjectAlertGroup.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	recipients := expandAlertRecipients(d.Get("recipients").([]interface{}))
+	err = checkProjectAlertGroupRecipients(projectAlertGroup.ProjectID, recipients)
+	if err != nil {
+		return err
+	}
+
+	err = meta.(*Config).SetAlertRecipientsType(recipients)
+	if err != nil {
+		return err
+	}
+
+	update := map[string]interface{}{
+		"name":                  d.Get("name").(string),
+		"description":           d.Get("description").(string),
+		"groupIntervalSeconds":  int64(d.Get("group_interval_seconds").(int)),
+		"groupWaitSeconds":      int64(d.Get("group_wait_seconds").(int)),
+		"recipients":            recipients,
+		"repeatIntervalSeconds": int64(d.Get("repeat_interval_seconds").(int)),
+		"annotations":           toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":                toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newProjectAlertGroup, err := client.ProjectAlertGroup.Update(projectAlertGroup, update)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    projectAlertGroupStateRefreshFunc(client, newProjectAlertGroup.ID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for project alert group (%s) to be updated: %s", newProjectAlertGroup.ID, waitErr)
+	}
+
+	return resourceRancher2ProjectAlertGroupRead(d, meta)
+}
+
+func resourceRancher2ProjectAlertGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Project Alert Group ID %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	projectAlertGroup, err := client.ProjectAlertGroup.ByID(id)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Project Alert Group ID %s not found.", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.ProjectAlertGroup.Delete(projectAlertGroup)
+	if err != nil {
+		return fmt.Errorf("Error removing Project Alert Group: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for project alert group (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    projectAlertGroupStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for project alert group (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// projectAlertGroupStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Project Alert Group.
+func projectAlertGroupStateRefreshFunc(client *managementClient.Client, projectAlertGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.ProjectAlertGroup.ByID(projectAlertGroupID)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
+
+// checkProjectAlertGroupRecipients checks that recipients notifiers belong to the project cluster
+func checkProjectAlertGroupRecipients(projectID string, recipients []managementClient.Recipient) error {
+	clusterID, _ := splitProjectID(projectID)
+
+	for _, recipient := range recipients {
+		notifierClusterID, _ := splitID(recipient.NotifierID)
+		if notifierClusterID != clusterID {
+			return fmt.Errorf("[ERROR] Notifier ID %s doesn't belong to project %s cluster", recipient.NotifierID, projectID)
+		}
+	}
+
+	return nil
+}
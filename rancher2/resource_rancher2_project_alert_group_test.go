@@ -0,0 +1,232 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	testAccRancher2ProjectAlertGroupType = "rancher2_project_alert_group"
+)
+
+var (
+	testAccRancher2ProjectAlertGroupProject        string
+	testAccRancher2ProjectAlertGroupConfig         string
+	testAccRancher2ProjectAlertGroupUpdateConfig   string
+	testAccRancher2ProjectAlertGroupRecreateConfig string
+)
+
+func init() {
+	testAccRancher2ProjectAlertGroupProject = `
+resource "rancher2_notifier" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  slack_config {
+    default_recipient = "#foo"
+    url = "http://foo.com:8080"
+  }
+}
+resource "rancher2_project" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform project alert group acceptance test"
+}
+`
+	testAccRancher2ProjectAlertGroupConfig = testAccRancher2ProjectAlertGroupProject + `
+resource "rancher2_project_alert_group" "foo" {
+  name = "foo"
+  project_id = "${rancher2_project.foo.id}"
+  description = "Terraform project alert group acceptance test"
+  group_interval_seconds = 180
+  recipients {
+    notifier_id = "${rancher2_notifier.foo.id}"
+  }
+}
+`
+
+	testAccRancher2ProjectAlertGroupUpdateConfig = testAccRancher2ProjectAlertGroupProject + `
+resource "rancher2_project_alert_group" "foo" {
+  name = "foo-updated"
+  project_id = "${rancher2_project.foo.id}"
+  description = "Terraform project alert group acceptance test - updated"
+  group_interval_seconds = 300
+  recipients {
+    notifier_id = "${rancher2_notifier.foo.id}"
+  }
+}
+ `
+
+	testAccRancher2ProjectAlertGroupRecreateConfig = testAccRancher2ProjectAlertGroupProject + `
+resource "rancher2_project_alert_group" "foo" {
+  name = "foo"
+  project_id = "${rancher2_project.foo.id}"
+  description = "Terraform project alert group acceptance test"
+  group_interval_seconds = 180
+  recipients {
+    notifier_id = "${rancher2_notifier.foo.id}"
+  }
+}
+ `
+}
+
+func TestAccRancher2ProjectAlertGroup_basic(t *testing.T) {
+	var alertGroup *managementClient.ProjectAlertGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2ProjectAlertGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2ProjectAlertGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ProjectAlertGroupExists(testAccRancher2ProjectAlertGroupType+".foo", alertGroup),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertGroupType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertGroupType+".foo", "description", "Terraform project alert group acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertGroupType+".foo", "group_interval_seconds", "180"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertGroupType+".foo", "recipients.0.notifier_type", alertRecipientTypeSlack),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2ProjectAlertGroupUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ProjectAlertGroupExists(testAccRancher2ProjectAlertGroupType+".foo", alertGroup),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertGroupType+".foo", "name", "foo-updated"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertGroupType+".foo", "description", "Terraform project alert group acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertGroupType+".foo", "group_interval_seconds", "300"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2ProjectAlertGroupRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ProjectAlertGroupExists(testAccRancher2ProjectAlertGroupType+".foo", alertGroup),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertGroupType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertGroupType+".foo", "description", "Terraform project alert group acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertGroupType+".foo", "group_interval_seconds", "180"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2ProjectAlertGroup_disappears(t *testing.T) {
+	var alertGroup *managementClient.ProjectAlertGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2ProjectAlertGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2ProjectAlertGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ProjectAlertGroupExists(testAccRancher2ProjectAlertGroupType+".foo", alertGroup),
+					testAccRancher2ProjectAlertGroupDisappears(alertGroup),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2ProjectAlertGroupDisappears(alertGroup *managementClient.ProjectAlertGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2ProjectAlertGroupType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ManagementClient()
+			if err != nil {
+				return err
+			}
+
+			alertGroup, err = client.ProjectAlertGroup.ByID(rs.Primary.ID)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.ProjectAlertGroup.Delete(alertGroup)
+			if err != nil {
+				return fmt.Errorf("Error removing Project Alert Group: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    projectAlertGroupStateRefreshFunc(client, alertGroup.ID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for project alert group (%s) to be removed: %s", alertGroup.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+func testAccCheckRancher2ProjectAlertGroupExists(n string, alertGroup *managementClient.ProjectAlertGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No project alert group ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		foundAlertGroup, err := client.ProjectAlertGroup.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("Project alert group not found")
+			}
+			return err
+		}
+
+		alertGroup = foundAlertGroup
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2ProjectAlertGroupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2ProjectAlertGroupType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.ProjectAlertGroup.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("Project alert group still exists")
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func resourceRancher2ProjectAlertRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2ProjectAlertRuleCreate,
+		Read:   resourceRancher2ProjectAlertRuleRead,
+		Update: resourceRancher2ProjectAlertRuleUpdate,
+		Delete: resourceRancher2ProjectAlertRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2ProjectAlertRuleImport,
+		},
+
+		Schema: projectAlertRuleFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2ProjectAlertRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	projectAlertRule := expandProjectAlertRule(d)
+
+	if projectAlertRule.MetricRule == nil && projectAlertRule.PodRule == nil && projectAlertRule.WorkloadRule == nil {
+		return fmt.Errorf("[ERROR] Creating Project Alert Rule: metric_rule, pod_rule or workload_rule should be provided")
+	}
+
+	err := meta.(*Config).ProjectExist(projectAlertRule.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Project Alert Rule %s", projectAlertRule.Name)
+
+	newProjectAlertRule, err := client.ProjectAlertRule.Create(projectAlertRule)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"activating"},
+		Target:     []string{"active"},
+		Refresh:    projectAlertRuleStateRefreshFunc(client, newProjectAlertRule.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for project alert rule (%s) to be created: %s", newProjectAlertRule.ID, waitErr)
+	}
+
+	d.SetId(newProjectAlertRule.ID)
+
+	err = setProjectAlertRuleState(client, newProjectAlertRule.ID, d.Get("enabled").(bool), d.Get("muted").(bool))
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2ProjectAlertRuleRead(d, meta)
+}
+
+func resourceRancher2ProjectAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Project Alert Rule ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	projectAlertRule, err := client.ProjectAlertRule.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Project Alert Rule ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenProjectAlertRule(d, projectAlertRule)
+}
+
+func resourceRancher2ProjectAlertRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Project Alert Rule ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	projectAlertRule, err := client.ProjectAlertRule.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Unset rules are sent as null, to allow changing the rule type
+	newRule := expandProjectAlertRule(d)
+	update := map[string]interface{}{
+		"groupId":               newRule.GroupID,
+		"name":                  newRule.Name,
+		"groupIntervalSeconds":  newRule.GroupIntervalSeconds,
+		"groupWaitSeconds":      newRule.GroupWaitSeconds,
+		"inherited":             newRule.Inherited,
+		"metricRule":            newRule.MetricRule,
+		"podRule":               newRule.PodRule,
+		"repeatIntervalSeconds": newRule.RepeatIntervalSeconds,
+		"severity":              newRule.Severity,
+		"workloadRule":          newRule.WorkloadRule,
+		"annotations":           toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":                toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newProjectAlertRule, err := client.ProjectAlertRule.Update(projectAlertRule, update)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    projectAlertRuleStateRefreshFunc(client, newProjectAlertRule.ID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for project alert rule (%s) to be updated: %s", newProjectAlertRule.ID, waitErr)
+	}
+
+	if d.HasChange("enabled") || d.HasChange("muted") {
+		err = setProjectAlertRuleState(client, newProjectAlertRule.ID, d.Get("enabled").(bool), d.Get("muted").(bool))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceRancher2ProjectAlertRuleRead(d, meta)
+}
+
+func resourceRancher2ProjectAlertRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Project Alert Rule ID %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	projectAlertRule, err := client.ProjectAlertRule.ByID(id)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Project Alert Rule ID %s not found.", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.ProjectAlertRule.Delete(projectAlertRule)
+	if err != nil {
+		return fmt.Errorf("Error removing Project Alert Rule: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for project alert rule (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    projectAlertRuleStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for project alert rule (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// projectAlertRuleStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Project Alert Rule.
+func projectAlertRuleStateRefreshFunc(client *managementClient.Client, projectAlertRuleID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.ProjectAlertRule.ByID(projectAlertRuleID)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
+
+// setProjectAlertRuleState calls activate, deactivate, mute or unmute actions to match enabled and muted arguments
+func setProjectAlertRuleState(client *managementClient.Client, projectAlertRuleID string, enabled, muted bool) error {
+	projectAlertRule, err := client.ProjectAlertRule.ByID(projectAlertRuleID)
+	if err != nil {
+		return err
+	}
+
+	if !enabled {
+		if projectAlertRule.AlertState == alertRuleStateInactive {
+			return nil
+		}
+		log.Printf("[INFO] Deactivating Project Alert Rule ID %s", projectAlertRuleID)
+		err = client.ProjectAlertRule.ActionDeactivate(projectAlertRule)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Deactivating Project Alert Rule ID %s: %s", projectAlertRuleID, err)
+		}
+		return nil
+	}
+
+	if projectAlertRule.AlertState == alertRuleStateInactive {
+		log.Printf("[INFO] Activating Project Alert Rule ID %s", projectAlertRuleID)
+		err = client.ProjectAlertRule.ActionActivate(projectAlertRule)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Activating Project Alert Rule ID %s: %s", projectAlertRuleID, err)
+		}
+		if !muted {
+			return nil
+		}
+		// Refreshing the rule to get its mute action
+		projectAlertRule, err = client.ProjectAlertRule.ByID(projectAlertRuleID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if muted && projectAlertRule.AlertState != alertRuleStateMuted {
+		log.Printf("[INFO] Muting Project Alert Rule ID %s", projectAlertRuleID)
+		err = client.ProjectAlertRule.ActionMute(projectAlertRule)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Muting Project Alert Rule ID %s: %s", projectAlertRuleID, err)
+		}
+	}
+
+	if !muted && projectAlertRule.AlertState == alertRuleStateMuted {
+		log.Printf("[INFO] Unmuting Project Alert Rule ID %s", projectAlertRuleID)
+		err = client.ProjectAlertRule.ActionUnmute(projectAlertRule)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Unmuting Project Alert Rule ID %s: %s", projectAlertRuleID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	testAccRancher2ProjectAlertRuleType = "rancher2_project_alert_rule"
+)
+
+var (
+	testAccRancher2ProjectAlertRuleGroup          string
+	testAccRancher2ProjectAlertRuleConfig         string
+	testAccRancher2ProjectAlertRuleUpdateConfig   string
+	testAccRancher2ProjectAlertRuleRecreateConfig string
+	testAccRancher2ProjectAlertRuleDisableConfig  string
+)
+
+func init() {
+	testAccRancher2ProjectAlertRuleGroup = `
+resource "rancher2_project" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform project alert rule acceptance test"
+}
+resource "rancher2_project_alert_group" "foo" {
+  name = "foo"
+  project_id = "${rancher2_project.foo.id}"
+  description = "Terraform project alert rule acceptance test"
+}
+`
+	testAccRancher2ProjectAlertRuleConfig = testAccRancher2ProjectAlertRuleGroup + `
+resource "rancher2_project_alert_rule" "foo" {
+  name = "foo"
+  project_id = "${rancher2_project.foo.id}"
+  group_id = "${rancher2_project_alert_group.foo.id}"
+  severity = "warning"
+  workload_rule {
+    available_percentage = 60
+    selector = {
+      app = "foo"
+    }
+  }
+}
+`
+
+	testAccRancher2ProjectAlertRuleUpdateConfig = testAccRancher2ProjectAlertRuleGroup + `
+resource "rancher2_project_alert_rule" "foo" {
+  name = "foo-updated"
+  project_id = "${rancher2_project.foo.id}"
+  group_id = "${rancher2_project_alert_group.foo.id}"
+  severity = "warning"
+  muted = true
+  metric_rule {
+    duration = "5m"
+    expression = "project:memory_usage"
+    comparison = "greater-than"
+    threshold_value = 0.8
+  }
+}
+ `
+
+	testAccRancher2ProjectAlertRuleRecreateConfig = testAccRancher2ProjectAlertRuleGroup + `
+resource "rancher2_project_alert_rule" "foo" {
+  name = "foo"
+  project_id = "${rancher2_project.foo.id}"
+  group_id = "${rancher2_project_alert_group.foo.id}"
+  severity = "warning"
+  workload_rule {
+    available_percentage = 60
+    selector = {
+      app = "foo"
+    }
+  }
+}
+ `
+
+	testAccRancher2ProjectAlertRuleDisableConfig = testAccRancher2ProjectAlertRuleGroup + `
+resource "rancher2_project_alert_rule" "foo" {
+  name = "foo"
+  project_id = "${rancher2_project.foo.id}"
+  group_id = "${rancher2_project_alert_group.foo.id}"
+  severity = "warning"
+  enabled = false
+  workload_rule {
+    available_percentage = 60
+    selector = {
+      app = "foo"
+    }
+  }
+}
+ `
+}
+
+func TestAccRancher2ProjectAlertRule_basic(t *testing.T) {
+	var alertRule *managementClient.ProjectAlertRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2ProjectAlertRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2ProjectAlertRuleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ProjectAlertRuleExists(testAccRancher2ProjectAlertRuleType+".foo", alertRule),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "severity", "warning"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "workload_rule.0.available_percentage", "60"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "muted", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2ProjectAlertRuleUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ProjectAlertRuleExists(testAccRancher2ProjectAlertRuleType+".foo", alertRule),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "name", "foo-updated"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "workload_rule.#", "0"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "metric_rule.0.expression", "project:memory_usage"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "muted", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2ProjectAlertRuleRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ProjectAlertRuleExists(testAccRancher2ProjectAlertRuleType+".foo", alertRule),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "metric_rule.#", "0"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "workload_rule.0.selector.app", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "muted", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2ProjectAlertRuleDisableConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ProjectAlertRuleExists(testAccRancher2ProjectAlertRuleType+".foo", alertRule),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectAlertRuleType+".foo", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2ProjectAlertRule_disappears(t *testing.T) {
+	var alertRule *managementClient.ProjectAlertRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2ProjectAlertRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2ProjectAlertRuleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2ProjectAlertRuleExists(testAccRancher2ProjectAlertRuleType+".foo", alertRule),
+					testAccRancher2ProjectAlertRuleDisappears(alertRule),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2ProjectAlertRuleDisappears(alertRule *managementClient.ProjectAlertRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2ProjectAlertRuleType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ManagementClient()
+			if err != nil {
+				return err
+			}
+
+			alertRule, err = client.ProjectAlertRule.ByID(rs.Primary.ID)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.ProjectAlertRule.Delete(alertRule)
+			if err != nil {
+				return fmt.Errorf("Error removing Project Alert Rule: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    projectAlertRuleStateRefreshFunc(client, alertRule.ID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for project alert rule (%s) to be removed: %s", alertRule.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+func testAccCheckRancher2ProjectAlertRuleExists(n string, alertRule *managementClient.ProjectAlertRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No project alert rule ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		foundAlertGroup, err := client.ProjectAlertRule.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("Project alert rule not found")
+			}
+			return err
+		}
+
+		alertRule = foundAlertGroup
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2ProjectAlertRuleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2ProjectAlertRuleType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.ProjectAlertRule.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("Project alert rule still exists")
+	}
+	return nil
+}
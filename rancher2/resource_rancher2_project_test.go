@@ -26,6 +26,12 @@ resource "rancher2_project" "foo" {
   name = "foo"
   cluster_id = "` + testAccRancher2ClusterID + `"
   description = "Terraform project acceptance test"
+  container_resource_limit {
+    limits_cpu = "20m"
+    limits_memory = "20Mi"
+    requests_cpu = "1m"
+    requests_memory = "1Mi"
+  }
   resource_quota {
     project_limit {
       limits_cpu = "2000m"
@@ -46,6 +52,13 @@ resource "rancher2_project" "foo" {
   name = "foo-updated"
   cluster_id = "` + testAccRancher2ClusterID + `"
   description = "Terraform project acceptance test - updated"
+  pod_security_policy_template_id = "restricted"
+  container_resource_limit {
+    limits_cpu = "30m"
+    limits_memory = "20Mi"
+    requests_cpu = "1m"
+    requests_memory = "1Mi"
+  }
   resource_quota {
     project_limit {
       limits_cpu = "1000m"
@@ -66,6 +79,12 @@ resource "rancher2_project" "foo" {
   name = "foo"
   cluster_id = "` + testAccRancher2ClusterID + `"
   description = "Terraform project acceptance test"
+  container_resource_limit {
+    limits_cpu = "20m"
+    limits_memory = "20Mi"
+    requests_cpu = "1m"
+    requests_memory = "1Mi"
+  }
   resource_quota {
     project_limit {
       limits_cpu = "2000m"
@@ -96,6 +115,7 @@ func TestAccRancher2Project_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "name", "foo"),
 					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "description", "Terraform project acceptance test"),
 					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "cluster_id", testAccRancher2ClusterID),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "container_resource_limit.0.limits_cpu", "20m"),
 				),
 			},
 			resource.TestStep{
@@ -104,7 +124,9 @@ func TestAccRancher2Project_basic(t *testing.T) {
 					testAccCheckRancher2ProjectExists(testAccRancher2ProjectType+".foo", project),
 					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "name", "foo-updated"),
 					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "description", "Terraform project acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "pod_security_policy_template_id", "restricted"),
 					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "cluster_id", testAccRancher2ClusterID),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "container_resource_limit.0.limits_cpu", "30m"),
 				),
 			},
 			resource.TestStep{
@@ -114,6 +136,8 @@ func TestAccRancher2Project_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "name", "foo"),
 					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "description", "Terraform project acceptance test"),
 					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "cluster_id", testAccRancher2ClusterID),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "container_resource_limit.0.limits_cpu", "20m"),
+					resource.TestCheckResourceAttr(testAccRancher2ProjectType+".foo", "pod_security_policy_template_id", ""),
 				),
 			},
 		},
@@ -0,0 +1,220 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+func resourceRancher2Registry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2RegistryCreate,
+		Read:   resourceRancher2RegistryRead,
+		Update: resourceRancher2RegistryUpdate,
+		Delete: resourceRancher2RegistryDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2RegistryImport,
+		},
+
+		Schema: registryFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2RegistryCreate(d *schema.ResourceData, meta interface{}) error {
+	projectID, err := setDefaultProjectID(d, meta)
+	if err != nil {
+		return err
+	}
+
+	err = meta.(*Config).ProjectExist(projectID)
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*Config).ProjectClient(projectID)
+	if err != nil {
+		return err
+	}
+
+	registry := expandRegistry(d)
+
+	log.Printf("[INFO] Creating Registry %s on Project ID %s", registry.Name, projectID)
+
+	newRegistry := &projectClient.DockerCredential{}
+	err = client.APIBaseClient.Create(registryType(registry.NamespaceId), registry, newRegistry)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"activating"},
+		Target:     []string{"active"},
+		Refresh:    registryStateRefreshFunc(client, newRegistry.ID, registry.NamespaceId),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for registry (%s) to be created: %s", newRegistry.ID, waitErr)
+	}
+
+	d.SetId(newRegistry.ID)
+	d.Set("registries_checksum", registryCredentialsChecksum(newRegistry.Registries))
+
+	return resourceRancher2RegistryRead(d, meta)
+}
+
+func resourceRancher2RegistryRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Registry ID %s", d.Id())
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	registry := &projectClient.DockerCredential{}
+	err = client.APIBaseClient.ByID(registryType(d.Get("namespace_id").(string)), d.Id(), registry)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Registry ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenRegistry(d, registry)
+}
+
+func resourceRancher2RegistryUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Registry ID %s", d.Id())
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	namespaceID := d.Get("namespace_id").(string)
+	registry := &projectClient.DockerCredential{}
+	err = client.APIBaseClient.ByID(registryType(namespaceID), d.Id(), registry)
+	if err != nil {
+		return err
+	}
+
+	update := map[string]interface{}{
+		"description": d.Get("description").(string),
+		"registries":  expandRegistryCredentials(d.Get("registries").([]interface{})),
+		"annotations": toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":      toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newRegistry := &projectClient.DockerCredential{}
+	err = client.APIBaseClient.Update(registryType(namespaceID), &registry.Resource, update, newRegistry)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    registryStateRefreshFunc(client, newRegistry.ID, namespaceID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for registry (%s) to be updated: %s", newRegistry.ID, waitErr)
+	}
+
+	d.Set("registries_checksum", registryCredentialsChecksum(newRegistry.Registries))
+
+	return resourceRancher2RegistryRead(d, meta)
+}
+
+func resourceRancher2RegistryDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Registry ID %s", d.Id())
+	id := d.Id()
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	namespaceID := d.Get("namespace_id").(string)
+	registry := &projectClient.DockerCredential{}
+	err = client.APIBaseClient.ByID(registryType(namespaceID), id, registry)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Registry ID %s not found.", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.APIBaseClient.Delete(&registry.Resource)
+	if err != nil {
+		return fmt.Errorf("Error removing Registry: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for registry (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    registryStateRefreshFunc(client, id, namespaceID),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for registry (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// registryType returns the registry schema type, namespacedDockerCredential if namespaceID is set
+func registryType(namespaceID string) string {
+	if len(namespaceID) > 0 {
+		return projectClient.NamespacedDockerCredentialType
+	}
+	return projectClient.DockerCredentialType
+}
+
+// registryStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Registry.
+func registryStateRefreshFunc(client *projectClient.Client, registryID, namespaceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj := &projectClient.DockerCredential{}
+		err := client.APIBaseClient.ByID(registryType(namespaceID), registryID, obj)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
@@ -0,0 +1,356 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+const (
+	testAccRancher2RegistryType = "rancher2_registry"
+)
+
+var (
+	testAccRancher2RegistryProject        string
+	testAccRancher2RegistryConfig         string
+	testAccRancher2RegistryUpdateConfig   string
+	testAccRancher2RegistryRecreateConfig string
+)
+
+func init() {
+	testAccRancher2RegistryProject = `
+resource "rancher2_project" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform registry acceptance test"
+  resource_quota {
+    project_limit {
+      limits_cpu = "2000m"
+      limits_memory = "2000Mi"
+      requests_storage = "2Gi"
+    }
+    namespace_default_limit {
+      limits_cpu = "500m"
+      limits_memory = "500Mi"
+      requests_storage = "1Gi"
+    }
+  }
+}
+resource "rancher2_namespace" "foo" {
+  name = "foo-registry"
+  description = "Terraform registry acceptance test"
+  project_id = "${rancher2_project.foo.id}"
+  resource_quota {
+    limit {
+      limits_cpu = "100m"
+      limits_memory = "100Mi"
+      requests_storage = "1Gi"
+    }
+  }
+}
+`
+
+	testAccRancher2RegistryConfig = testAccRancher2RegistryProject + `
+resource "rancher2_registry" "foo" {
+  name = "foo"
+  description = "Terraform registry acceptance test"
+  project_id = "${rancher2_project.foo.id}"
+  registries {
+    address = "test.io"
+    username = "user"
+    password = "pass"
+  }
+}
+resource "rancher2_registry" "foo-ns" {
+  name = "foo-ns"
+  description = "Terraform registry acceptance test"
+  project_id = "${rancher2_project.foo.id}"
+  namespace_id = "${rancher2_namespace.foo.id}"
+  registries {
+    address = "test.io"
+    username = "user"
+    password = "pass"
+  }
+}
+`
+
+	testAccRancher2RegistryUpdateConfig = testAccRancher2RegistryProject + `
+resource "rancher2_registry" "foo" {
+  name = "foo"
+  description = "Terraform registry acceptance test - updated"
+  project_id = "${rancher2_project.foo.id}"
+  registries {
+    address = "test.io"
+    username = "user2"
+    password = "pass2"
+  }
+}
+resource "rancher2_registry" "foo-ns" {
+  name = "foo-ns"
+  description = "Terraform registry acceptance test - updated"
+  project_id = "${rancher2_project.foo.id}"
+  namespace_id = "${rancher2_namespace.foo.id}"
+  registries {
+    address = "test.io"
+    username = "user2"
+    password = "pass2"
+  }
+}
+ `
+
+	testAccRancher2RegistryRecreateConfig = testAccRancher2RegistryProject + `
+resource "rancher2_registry" "foo" {
+  name = "foo"
+  description = "Terraform registry acceptance test"
+  project_id = "${rancher2_project.foo.id}"
+  registries {
+    address = "test.io"
+    username = "user"
+    password = "pass"
+  }
+}
+resource "rancher2_registry" "foo-ns" {
+  name = "foo-ns"
+  description = "Terraform registry acceptance test"
+  project_id = "${rancher2_project.foo.id}"
+  namespace_id = "${rancher2_namespace.foo.id}"
+  registries {
+    address = "test.io"
+    username = "user"
+    password = "pass"
+  }
+}
+ `
+}
+
+func TestAccRancher2Registry_basic(t *testing.T) {
+	var registry *projectClient.DockerCredential
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2RegistryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2RegistryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2RegistryExists(testAccRancher2RegistryType+".foo", registry),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo", "description", "Terraform registry acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo", "registries.0.username", "user"),
+					testAccCheckRancher2RegistryExists(testAccRancher2RegistryType+".foo-ns", registry),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo-ns", "name", "foo-ns"),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo-ns", "namespace_id", "foo-registry"),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo-ns", "registries.0.password", "pass"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2RegistryUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2RegistryExists(testAccRancher2RegistryType+".foo", registry),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo", "description", "Terraform registry acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo", "registries.0.username", "user2"),
+					testAccCheckRancher2RegistryExists(testAccRancher2RegistryType+".foo-ns", registry),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo-ns", "description", "Terraform registry acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo-ns", "registries.0.password", "pass2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2RegistryRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2RegistryExists(testAccRancher2RegistryType+".foo", registry),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo", "description", "Terraform registry acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo", "registries.0.username", "user"),
+					testAccCheckRancher2RegistryExists(testAccRancher2RegistryType+".foo-ns", registry),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo-ns", "description", "Terraform registry acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2RegistryType+".foo-ns", "registries.0.password", "pass"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2Registry_disappears(t *testing.T) {
+	var registry *projectClient.DockerCredential
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2RegistryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2RegistryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2RegistryExists(testAccRancher2RegistryType+".foo", registry),
+					testAccRancher2RegistryDisappears(registry),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRancher2Registry_drift(t *testing.T) {
+	var registry *projectClient.DockerCredential
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2RegistryDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2RegistryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2RegistryExists(testAccRancher2RegistryType+".foo", registry),
+					resource.TestCheckResourceAttrSet(testAccRancher2RegistryType+".foo", "registries_checksum"),
+					testAccRancher2RegistryDrift(testAccRancher2RegistryType+".foo"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2RegistryDisappears(registry *projectClient.DockerCredential) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2RegistryType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ProjectClient(rs.Primary.Attributes["project_id"])
+			if err != nil {
+				return err
+			}
+
+			namespaceID := rs.Primary.Attributes["namespace_id"]
+			registry = &projectClient.DockerCredential{}
+			err = client.APIBaseClient.ByID(registryType(namespaceID), rs.Primary.ID, registry)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.APIBaseClient.Delete(&registry.Resource)
+			if err != nil {
+				return fmt.Errorf("Error removing Registry: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    registryStateRefreshFunc(client, registry.ID, namespaceID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for registry (%s) to be removed: %s", registry.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+// testAccRancher2RegistryDrift changes registry credentials outside of terraform
+func testAccRancher2RegistryDrift(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ProjectClient(rs.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+
+		namespaceID := rs.Primary.Attributes["namespace_id"]
+		registry := &projectClient.DockerCredential{}
+		err = client.APIBaseClient.ByID(registryType(namespaceID), rs.Primary.ID, registry)
+		if err != nil {
+			return err
+		}
+
+		update := map[string]interface{}{
+			"registries": map[string]projectClient.RegistryCredential{
+				"test.io": projectClient.RegistryCredential{
+					Username: "user",
+					Password: "drifted",
+				},
+			},
+		}
+
+		return client.APIBaseClient.Update(registryType(namespaceID), &registry.Resource, update, nil)
+	}
+}
+
+func testAccCheckRancher2RegistryExists(n string, registry *projectClient.DockerCredential) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No registry ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ProjectClient(rs.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+
+		foundRegistry := &projectClient.DockerCredential{}
+		err = client.APIBaseClient.ByID(registryType(rs.Primary.Attributes["namespace_id"]), rs.Primary.ID, foundRegistry)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("Registry not found")
+			}
+			return err
+		}
+
+		registry = foundRegistry
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2RegistryDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2RegistryType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ProjectClient(rs.Primary.Attributes["project_id"])
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		registry := &projectClient.DockerCredential{}
+		err = client.APIBaseClient.ByID(registryType(rs.Primary.Attributes["namespace_id"]), rs.Primary.ID, registry)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("Registry still exists")
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func resourceRancher2RoleTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2RoleTemplateCreate,
+		Read:   resourceRancher2RoleTemplateRead,
+		Update: resourceRancher2RoleTemplateUpdate,
+		Delete: resourceRancher2RoleTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2RoleTemplateImport,
+		},
+
+		Schema: roleTemplateFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2RoleTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	roleTemplate := expandRoleTemplate(d)
+
+	for _, id := range roleTemplate.RoleTemplateIDs {
+		err := meta.(*Config).RoleTemplateExist(id)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Getting inherited Role Template ID %s: %s", id, err)
+		}
+	}
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Role Template %s", roleTemplate.Name)
+
+	newRoleTemplate, err := client.RoleTemplate.Create(roleTemplate)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    roleTemplateStateRefreshFunc(client, newRoleTemplate.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for role template (%s) to be created: %s", newRoleTemplate.ID, waitErr)
+	}
+
+	err = flattenRoleTemplate(d, newRoleTemplate)
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2RoleTemplateRead(d, meta)
+}
+
+func resourceRancher2RoleTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Role Template ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	roleTemplate, err := client.RoleTemplate.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Role Template ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = flattenRoleTemplate(d, roleTemplate)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceRancher2RoleTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Role Template ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	roleTemplate, err := client.RoleTemplate.ByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	update := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"administrative":  d.Get("administrative").(bool),
+		"external":        d.Get("external").(bool),
+		"hidden":          d.Get("hidden").(bool),
+		"locked":          d.Get("locked").(bool),
+		"roleTemplateIds": toArrayString(d.Get("role_template_ids").([]interface{})),
+		"rules":           expandPolicyRules(d.Get("rules").([]interface{})),
+		"annotations":     toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":          toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	switch d.Get("context").(string) {
+	case roleTemplateContextCluster:
+		update["clusterCreatorDefault"] = d.Get("default_role").(bool)
+	case roleTemplateContextProject:
+		update["projectCreatorDefault"] = d.Get("default_role").(bool)
+	}
+
+	newRoleTemplate, err := client.RoleTemplate.Update(roleTemplate, update)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    roleTemplateStateRefreshFunc(client, newRoleTemplate.ID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for role template (%s) to be updated: %s", newRoleTemplate.ID, waitErr)
+	}
+
+	return resourceRancher2RoleTemplateRead(d, meta)
+}
+
+func resourceRancher2RoleTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Role Template ID %s", d.Id())
+	id := d.Id()
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	roleTemplate, err := client.RoleTemplate.ByID(id)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Role Template ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.RoleTemplate.Delete(roleTemplate)
+	if err != nil {
+		return fmt.Errorf("Error removing Role Template: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for role template (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    roleTemplateStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for role template (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// roleTemplateStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Role Template.
+func roleTemplateStateRefreshFunc(client *managementClient.Client, roleTemplateID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj, err := client.RoleTemplate.ByID(roleTemplateID)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
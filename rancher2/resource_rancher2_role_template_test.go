@@ -0,0 +1,217 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	testAccRancher2RoleTemplateType   = "rancher2_role_template"
+	testAccRancher2RoleTemplateConfig = `
+resource "rancher2_role_template" "foo" {
+  name = "foo"
+  context = "cluster"
+  default_role = true
+  description = "Terraform role template acceptance test"
+  rules {
+    api_groups = ["*"]
+    resources = ["secrets"]
+    verbs = ["create"]
+  }
+}
+`
+
+	testAccRancher2RoleTemplateUpdateConfig = `
+resource "rancher2_role_template" "foo" {
+  name = "foo-updated"
+  context = "cluster"
+  default_role = false
+  description = "Terraform role template acceptance test - updated"
+  role_template_ids = ["cluster-member"]
+  rules {
+    api_groups = ["*"]
+    resources = ["secrets"]
+    verbs = ["create", "list"]
+  }
+}
+ `
+
+	testAccRancher2RoleTemplateRecreateConfig = `
+resource "rancher2_role_template" "foo" {
+  name = "foo"
+  context = "cluster"
+  default_role = true
+  description = "Terraform role template acceptance test"
+  rules {
+    api_groups = ["*"]
+    resources = ["secrets"]
+    verbs = ["create"]
+  }
+}
+ `
+)
+
+func TestAccRancher2RoleTemplate_basic(t *testing.T) {
+	var roleTemplate *managementClient.RoleTemplate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2RoleTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2RoleTemplateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2RoleTemplateExists(testAccRancher2RoleTemplateType+".foo", roleTemplate),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "description", "Terraform role template acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "default_role", "true"),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "rules.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2RoleTemplateUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2RoleTemplateExists(testAccRancher2RoleTemplateType+".foo", roleTemplate),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "name", "foo-updated"),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "description", "Terraform role template acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "default_role", "false"),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "role_template_ids.#", "1"),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "rules.0.verbs.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2RoleTemplateRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2RoleTemplateExists(testAccRancher2RoleTemplateType+".foo", roleTemplate),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "description", "Terraform role template acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "default_role", "true"),
+					resource.TestCheckResourceAttr(testAccRancher2RoleTemplateType+".foo", "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2RoleTemplate_disappears(t *testing.T) {
+	var roleTemplate *managementClient.RoleTemplate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2RoleTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2RoleTemplateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2RoleTemplateExists(testAccRancher2RoleTemplateType+".foo", roleTemplate),
+					testAccRancher2RoleTemplateDisappears(roleTemplate),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2RoleTemplateDisappears(roleTemplate *managementClient.RoleTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2RoleTemplateType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ManagementClient()
+			if err != nil {
+				return err
+			}
+
+			roleTemplate, err = client.RoleTemplate.ByID(rs.Primary.ID)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.RoleTemplate.Delete(roleTemplate)
+			if err != nil {
+				return fmt.Errorf("Error removing Role Template: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    roleTemplateStateRefreshFunc(client, roleTemplate.ID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for role template (%s) to be removed: %s", roleTemplate.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+func testAccCheckRancher2RoleTemplateExists(n string, roleTemplate *managementClient.RoleTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No role template ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		foundRoleTemplate, err := client.RoleTemplate.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("Role Template not found")
+			}
+			return err
+		}
+
+		roleTemplate = foundRoleTemplate
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2RoleTemplateDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2RoleTemplateType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ManagementClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.RoleTemplate.ByID(rs.Primary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("Role Template still exists")
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+func resourceRancher2Secret() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2SecretCreate,
+		Read:   resourceRancher2SecretRead,
+		Update: resourceRancher2SecretUpdate,
+		Delete: resourceRancher2SecretDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRancher2SecretImport,
+		},
+
+		Schema: secretFields(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceRancher2SecretCreate(d *schema.ResourceData, meta interface{}) error {
+	projectID, err := setDefaultProjectID(d, meta)
+	if err != nil {
+		return err
+	}
+
+	err = meta.(*Config).ProjectExist(projectID)
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*Config).ProjectClient(projectID)
+	if err != nil {
+		return err
+	}
+
+	secret := expandSecret(d)
+
+	log.Printf("[INFO] Creating Secret %s on Project ID %s", secret.Name, projectID)
+
+	newSecret := &projectClient.Secret{}
+	err = client.APIBaseClient.Create(secretType(secret.NamespaceId), secret, newSecret)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"activating"},
+		Target:     []string{"active"},
+		Refresh:    secretStateRefreshFunc(client, newSecret.ID, secret.NamespaceId),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for secret (%s) to be created: %s", newSecret.ID, waitErr)
+	}
+
+	d.SetId(newSecret.ID)
+	d.Set("data_checksum", secretDataChecksum(newSecret.Data))
+
+	return resourceRancher2SecretRead(d, meta)
+}
+
+func resourceRancher2SecretRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Secret ID %s", d.Id())
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	secret := &projectClient.Secret{}
+	err = client.APIBaseClient.ByID(secretType(d.Get("namespace_id").(string)), d.Id(), secret)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Secret ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return flattenSecret(d, secret)
+}
+
+func resourceRancher2SecretUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Secret ID %s", d.Id())
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	namespaceID := d.Get("namespace_id").(string)
+	secret := &projectClient.Secret{}
+	err = client.APIBaseClient.ByID(secretType(namespaceID), d.Id(), secret)
+	if err != nil {
+		return err
+	}
+
+	update := map[string]interface{}{
+		"description": d.Get("description").(string),
+		"data":        expandSecretData(d.Get("data").(map[string]interface{})),
+		"annotations": toMapString(d.Get("annotations").(map[string]interface{})),
+		"labels":      toMapString(d.Get("labels").(map[string]interface{})),
+	}
+
+	newSecret := &projectClient.Secret{}
+	err = client.APIBaseClient.Update(secretType(namespaceID), &secret.Resource, update, newSecret)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"active"},
+		Refresh:    secretStateRefreshFunc(client, newSecret.ID, namespaceID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for secret (%s) to be updated: %s", newSecret.ID, waitErr)
+	}
+
+	d.Set("data_checksum", secretDataChecksum(newSecret.Data))
+
+	return resourceRancher2SecretRead(d, meta)
+}
+
+func resourceRancher2SecretDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Secret ID %s", d.Id())
+	id := d.Id()
+
+	client, err := meta.(*Config).ProjectClient(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	namespaceID := d.Get("namespace_id").(string)
+	secret := &projectClient.Secret{}
+	err = client.APIBaseClient.ByID(secretType(namespaceID), id, secret)
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Secret ID %s not found.", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = client.APIBaseClient.Delete(&secret.Resource)
+	if err != nil {
+		return fmt.Errorf("Error removing Secret: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for secret (%s) to be removed", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"removed"},
+		Refresh:    secretStateRefreshFunc(client, id, namespaceID),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, waitErr := stateConf.WaitForState()
+	if waitErr != nil {
+		return fmt.Errorf(
+			"[ERROR] waiting for secret (%s) to be removed: %s", id, waitErr)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// secretType returns the secret schema type, namespacedSecret if namespaceID is set
+func secretType(namespaceID string) string {
+	if len(namespaceID) > 0 {
+		return projectClient.NamespacedSecretType
+	}
+	return projectClient.SecretType
+}
+
+// secretStateRefreshFunc returns a resource.StateRefreshFunc, used to watch a Rancher Secret.
+func secretStateRefreshFunc(client *projectClient.Client, secretID, namespaceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		obj := &projectClient.Secret{}
+		err := client.APIBaseClient.ByID(secretType(namespaceID), secretID, obj)
+		if err != nil {
+			if IsNotFound(err) {
+				return obj, "removed", nil
+			}
+			return nil, "", err
+		}
+
+		if obj.Removed != "" {
+			return obj, "removed", nil
+		}
+
+		return obj, "active", nil
+	}
+}
@@ -0,0 +1,355 @@
+package rancher2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+const (
+	testAccRancher2SecretType = "rancher2_secret"
+)
+
+var (
+	testAccRancher2SecretProject        string
+	testAccRancher2SecretConfig         string
+	testAccRancher2SecretUpdateConfig   string
+	testAccRancher2SecretRecreateConfig string
+)
+
+func init() {
+	testAccRancher2SecretProject = `
+resource "rancher2_project" "foo" {
+  name = "foo"
+  cluster_id = "` + testAccRancher2ClusterID + `"
+  description = "Terraform secret acceptance test"
+  resource_quota {
+    project_limit {
+      limits_cpu = "2000m"
+      limits_memory = "2000Mi"
+      requests_storage = "2Gi"
+    }
+    namespace_default_limit {
+      limits_cpu = "500m"
+      limits_memory = "500Mi"
+      requests_storage = "1Gi"
+    }
+  }
+}
+resource "rancher2_namespace" "foo" {
+  name = "foo-secret"
+  description = "Terraform secret acceptance test"
+  project_id = "${rancher2_project.foo.id}"
+  resource_quota {
+    limit {
+      limits_cpu = "100m"
+      limits_memory = "100Mi"
+      requests_storage = "1Gi"
+    }
+  }
+}
+`
+
+	testAccRancher2SecretConfig = testAccRancher2SecretProject + `
+resource "rancher2_secret" "foo" {
+  name = "foo"
+  description = "Terraform secret acceptance test"
+  project_id = "${rancher2_project.foo.id}"
+  data = {
+    address = "test.io"
+    username = "user"
+    password = "pass"
+  }
+}
+resource "rancher2_secret" "foo-ns" {
+  name = "foo-ns"
+  description = "Terraform secret acceptance test"
+  project_id = "${rancher2_project.foo.id}"
+  namespace_id = "${rancher2_namespace.foo.id}"
+  data = {
+    address = "test.io"
+    username = "user"
+    password = "pass"
+  }
+}
+`
+
+	testAccRancher2SecretUpdateConfig = testAccRancher2SecretProject + `
+resource "rancher2_secret" "foo" {
+  name = "foo"
+  description = "Terraform secret acceptance test - updated"
+  project_id = "${rancher2_project.foo.id}"
+  data = {
+    address = "test.io"
+    username = "user2"
+    password = "pass2"
+  }
+}
+resource "rancher2_secret" "foo-ns" {
+  name = "foo-ns"
+  description = "Terraform secret acceptance test - updated"
+  project_id = "${rancher2_project.foo.id}"
+  namespace_id = "${rancher2_namespace.foo.id}"
+  data = {
+    address = "test.io"
+    username = "user2"
+    password = "pass2"
+  }
+}
+ `
+
+	testAccRancher2SecretRecreateConfig = testAccRancher2SecretProject + `
+resource "rancher2_secret" "foo" {
+  name = "foo"
+  description = "Terraform secret acceptance test"
+  project_id = "${rancher2_project.foo.id}"
+  data = {
+    address = "test.io"
+    username = "user"
+    password = "pass"
+  }
+}
+resource "rancher2_secret" "foo-ns" {
+  name = "foo-ns"
+  description = "Terraform secret acceptance test"
+  project_id = "${rancher2_project.foo.id}"
+  namespace_id = "${rancher2_namespace.foo.id}"
+  data = {
+    address = "test.io"
+    username = "user"
+    password = "pass"
+  }
+}
+ `
+}
+
+func TestAccRancher2Secret_basic(t *testing.T) {
+	var secret *projectClient.Secret
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2SecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2SecretConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2SecretExists(testAccRancher2SecretType+".foo", secret),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo", "description", "Terraform secret acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo", "data.username", "user"),
+					testAccCheckRancher2SecretExists(testAccRancher2SecretType+".foo-ns", secret),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo-ns", "name", "foo-ns"),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo-ns", "namespace_id", "foo-secret"),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo-ns", "data.password", "pass"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2SecretUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2SecretExists(testAccRancher2SecretType+".foo", secret),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo", "description", "Terraform secret acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo", "data.username", "user2"),
+					testAccCheckRancher2SecretExists(testAccRancher2SecretType+".foo-ns", secret),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo-ns", "description", "Terraform secret acceptance test - updated"),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo-ns", "data.password", "pass2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccRancher2SecretRecreateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2SecretExists(testAccRancher2SecretType+".foo", secret),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo", "name", "foo"),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo", "description", "Terraform secret acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo", "data.username", "user"),
+					testAccCheckRancher2SecretExists(testAccRancher2SecretType+".foo-ns", secret),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo-ns", "description", "Terraform secret acceptance test"),
+					resource.TestCheckResourceAttr(testAccRancher2SecretType+".foo-ns", "data.password", "pass"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRancher2Secret_disappears(t *testing.T) {
+	var secret *projectClient.Secret
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2SecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2SecretConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2SecretExists(testAccRancher2SecretType+".foo", secret),
+					testAccRancher2SecretDisappears(secret),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRancher2Secret_drift(t *testing.T) {
+	var secret *projectClient.Secret
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRancher2SecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRancher2SecretConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRancher2SecretExists(testAccRancher2SecretType+".foo", secret),
+					resource.TestCheckResourceAttrSet(testAccRancher2SecretType+".foo", "data_checksum"),
+					testAccRancher2SecretDrift(testAccRancher2SecretType+".foo"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRancher2SecretDisappears(secret *projectClient.Secret) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != testAccRancher2SecretType {
+				continue
+			}
+			client, err := testAccProvider.Meta().(*Config).ProjectClient(rs.Primary.Attributes["project_id"])
+			if err != nil {
+				return err
+			}
+
+			namespaceID := rs.Primary.Attributes["namespace_id"]
+			secret = &projectClient.Secret{}
+			err = client.APIBaseClient.ByID(secretType(namespaceID), rs.Primary.ID, secret)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+
+			err = client.APIBaseClient.Delete(&secret.Resource)
+			if err != nil {
+				return fmt.Errorf("Error removing Secret: %s", err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"active"},
+				Target:     []string{"removed"},
+				Refresh:    secretStateRefreshFunc(client, secret.ID, namespaceID),
+				Timeout:    10 * time.Minute,
+				Delay:      1 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, waitErr := stateConf.WaitForState()
+			if waitErr != nil {
+				return fmt.Errorf(
+					"[ERROR] waiting for secret (%s) to be removed: %s", secret.ID, waitErr)
+			}
+		}
+		return nil
+
+	}
+}
+
+// testAccRancher2SecretDrift changes secret data outside of terraform
+func testAccRancher2SecretDrift(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ProjectClient(rs.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+
+		namespaceID := rs.Primary.Attributes["namespace_id"]
+		secret := &projectClient.Secret{}
+		err = client.APIBaseClient.ByID(secretType(namespaceID), rs.Primary.ID, secret)
+		if err != nil {
+			return err
+		}
+
+		update := map[string]interface{}{
+			"data": map[string]string{
+				"address":  Base64Encode("test.io"),
+				"username": Base64Encode("user"),
+				"password": Base64Encode("drifted"),
+			},
+		}
+
+		return client.APIBaseClient.Update(secretType(namespaceID), &secret.Resource, update, nil)
+	}
+}
+
+func testAccCheckRancher2SecretExists(n string, secret *projectClient.Secret) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No secret ID is set")
+		}
+
+		client, err := testAccProvider.Meta().(*Config).ProjectClient(rs.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+
+		foundSecret := &projectClient.Secret{}
+		err = client.APIBaseClient.ByID(secretType(rs.Primary.Attributes["namespace_id"]), rs.Primary.ID, foundSecret)
+		if err != nil {
+			if IsNotFound(err) {
+				return fmt.Errorf("Secret not found")
+			}
+			return err
+		}
+
+		secret = foundSecret
+
+		return nil
+	}
+}
+
+func testAccCheckRancher2SecretDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccRancher2SecretType {
+			continue
+		}
+		client, err := testAccProvider.Meta().(*Config).ProjectClient(rs.Primary.Attributes["project_id"])
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		secret := &projectClient.Secret{}
+		err = client.APIBaseClient.ByID(secretType(rs.Primary.Attributes["namespace_id"]), rs.Primary.ID, secret)
+		if err != nil {
+			if IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return fmt.Errorf("Secret still exists")
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package rancher2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceRancher2Token() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRancher2TokenCreate,
+		Read:   resourceRancher2TokenRead,
+		Update: resourceRancher2TokenUpdate,
+		Delete: resourceRancher2TokenDelete,
+
+		Schema: tokenFields(),
+	}
+}
+
+func resourceRancher2TokenCreate(d *schema.ResourceData, meta interface{}) error {
+	err := resourceRancher2TokenGenerate(d, meta)
+	if err != nil {
+		return err
+	}
+
+	return resourceRancher2TokenRead(d, meta)
+}
+
+func resourceRancher2TokenRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Refreshing Token ID %s", d.Id())
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	token, err := client.Token.ByID(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			log.Printf("[INFO] Token ID %s not found.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = flattenToken(d, token)
+	if err != nil {
+		return err
+	}
+
+	// Forcing token renewal on next apply if it's expired
+	if d.Get("renew").(bool) && token.Expired {
+		log.Printf("[INFO] Token ID %s is expired and will be renewed", d.Id())
+		d.Set("renew", false)
+	}
+
+	return nil
+}
+
+func resourceRancher2TokenUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Updating Token ID %s", d.Id())
+
+	expired, err := meta.(*Config).IsTokenExpired(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.Get("renew").(bool) && expired {
+		oldTokenID := d.Id()
+
+		err = resourceRancher2TokenGenerate(d, meta)
+		if err != nil {
+			return err
+		}
+
+		err = meta.(*Config).DeleteToken(oldTokenID)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Deleting expired Token ID %s: %s", oldTokenID, err)
+		}
+	}
+
+	return resourceRancher2TokenRead(d, meta)
+}
+
+func resourceRancher2TokenDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Deleting Token ID %s", d.Id())
+
+	err := meta.(*Config).DeleteToken(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error removing Token: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceRancher2TokenGenerate(d *schema.ResourceData, meta interface{}) error {
+	token := expandToken(d)
+
+	if len(token.ClusterID) > 0 {
+		err := meta.(*Config).ClusterExist(token.ClusterID)
+		if err != nil {
+			return err
+		}
+	}
+
+	client, err := meta.(*Config).ManagementClient()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Token %s", token.Description)
+
+	newToken, err := client.Token.Create(token)
+	if err != nil {
+		return err
+	}
+
+	return flattenToken(d, newToken)
+}
@@ -0,0 +1,36 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	alertEventRuleTypeNormal  = "Normal"
+	alertEventRuleTypeWarning = "Warning"
+)
+
+var (
+	alertEventRuleTypes         = []string{alertEventRuleTypeNormal, alertEventRuleTypeWarning}
+	alertEventRuleResourceKinds = []string{"DaemonSet", "Deployment", "Node", "Pod", "StatefulSet"}
+)
+
+//Schemas
+
+func alertEventRuleFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"resource_kind": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(alertEventRuleResourceKinds, false),
+		},
+		"event_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      alertEventRuleTypeWarning,
+			ValidateFunc: validation.StringInSlice(alertEventRuleTypes, false),
+		},
+	}
+
+	return s
+}
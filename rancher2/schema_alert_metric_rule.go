@@ -0,0 +1,53 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	alertMetricRuleComparisonEqual = "equal"
+)
+
+var (
+	alertMetricRuleComparisons = []string{
+		alertMetricRuleComparisonEqual,
+		"greater-or-equal",
+		"greater-than",
+		"has-value",
+		"less-or-equal",
+		"less-than",
+		"not-equal",
+	}
+)
+
+//Schemas
+
+func alertMetricRuleFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"duration": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"expression": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"threshold_value": {
+			Type:     schema.TypeFloat,
+			Required: true,
+		},
+		"comparison": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      alertMetricRuleComparisonEqual,
+			ValidateFunc: validation.StringInSlice(alertMetricRuleComparisons, false),
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	return s
+}
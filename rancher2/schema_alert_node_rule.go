@@ -0,0 +1,52 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	alertNodeRuleConditionCPU      = "cpu"
+	alertNodeRuleConditionMem      = "mem"
+	alertNodeRuleConditionNotReady = "notready"
+	alertNodeRuleThresholdDefault  = 70
+)
+
+var (
+	alertNodeRuleConditions = []string{alertNodeRuleConditionCPU, alertNodeRuleConditionMem, alertNodeRuleConditionNotReady}
+)
+
+//Schemas
+
+func alertNodeRuleFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"condition": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      alertNodeRuleConditionNotReady,
+			ValidateFunc: validation.StringInSlice(alertNodeRuleConditions, false),
+		},
+		"cpu_threshold": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertNodeRuleThresholdDefault,
+		},
+		"mem_threshold": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertNodeRuleThresholdDefault,
+		},
+		"node_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"node_rule.0.selector"},
+		},
+		"selector": {
+			Type:          schema.TypeMap,
+			Optional:      true,
+			ConflictsWith: []string{"node_rule.0.node_id"},
+		},
+	}
+
+	return s
+}
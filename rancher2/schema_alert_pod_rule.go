@@ -0,0 +1,47 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	alertPodRuleConditionNotRunning           = "notrunning"
+	alertPodRuleConditionNotScheduled         = "notscheduled"
+	alertPodRuleConditionRestarts             = "restarts"
+	alertPodRuleRestartIntervalSecondsDefault = 300
+	alertPodRuleRestartTimesDefault           = 3
+)
+
+var (
+	alertPodRuleConditions = []string{alertPodRuleConditionNotRunning, alertPodRuleConditionNotScheduled, alertPodRuleConditionRestarts}
+)
+
+//Schemas
+
+func alertPodRuleFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"pod_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"condition": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      alertPodRuleConditionNotRunning,
+			ValidateFunc: validation.StringInSlice(alertPodRuleConditions, false),
+		},
+		"restart_interval_seconds": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertPodRuleRestartIntervalSecondsDefault,
+		},
+		"restart_times": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertPodRuleRestartTimesDefault,
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,41 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	alertRecipientTypeEmail     = "email"
+	alertRecipientTypePagerduty = "pagerduty"
+	alertRecipientTypeSlack     = "slack"
+	alertRecipientTypeWebhook   = "webhook"
+	alertRecipientTypeWechat    = "wechat"
+)
+
+var (
+	alertRecipientTypes = []string{alertRecipientTypeEmail, alertRecipientTypePagerduty, alertRecipientTypeSlack, alertRecipientTypeWebhook, alertRecipientTypeWechat}
+)
+
+//Schemas
+
+func alertRecipientFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"notifier_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"notifier_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(alertRecipientTypes, false),
+		},
+		"recipient": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,29 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	alertSystemServiceRuleConditionScheduler = "scheduler"
+)
+
+var (
+	alertSystemServiceRuleConditions = []string{"controller-manager", "etcd", alertSystemServiceRuleConditionScheduler}
+)
+
+//Schemas
+
+func alertSystemServiceRuleFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"condition": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      alertSystemServiceRuleConditionScheduler,
+			ValidateFunc: validation.StringInSlice(alertSystemServiceRuleConditions, false),
+		},
+	}
+
+	return s
+}
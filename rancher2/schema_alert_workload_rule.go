@@ -0,0 +1,33 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	alertWorkloadRuleAvailablePercentageDefault = 70
+)
+
+//Schemas
+
+func alertWorkloadRuleFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"available_percentage": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertWorkloadRuleAvailablePercentageDefault,
+		},
+		"selector": {
+			Type:          schema.TypeMap,
+			Optional:      true,
+			ConflictsWith: []string{"workload_rule.0.workload_id"},
+		},
+		"workload_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"workload_rule.0.selector"},
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,89 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	appCatalogTypeCluster = "clusterCatalog"
+	appCatalogTypeProject = "projectCatalog"
+	appConditionDeployed  = "Deployed"
+	appExternalIDPrefix   = "catalog://?"
+)
+
+//Schemas
+
+func appFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"catalog_name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"project_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"target_namespace": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"template_name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"template_version": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"answers": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"force_upgrade": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"prune": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"values_yaml": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"external_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"revision_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"annotations": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"labels": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,76 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	appRevisionAppIDLabel = "io.cattle.field/appId"
+)
+
+//Schemas
+
+func appRevisionFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"answers": &schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+		"created": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"current": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"external_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"template_version": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"values_yaml": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	return s
+}
+
+func appRevisionsFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"app_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"project_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"current_revision_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"revisions": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: appRevisionFields(),
+			},
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,33 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//Schemas
+
+func appRollbackFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"app_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"project_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"revision_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"force_upgrade": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+
+	return s
+}
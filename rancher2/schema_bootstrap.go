@@ -9,7 +9,7 @@ const (
 	bootstrapDefaultSessionDesc = "Terraform bootstrap admin session"
 	bootstrapDefaultUser        = "admin"
 	bootstrapDefaultPassword    = "admin"
-	bootstrapDefaultTTL         = "60000"
+	bootstrapDefaultTTL         = 60000
 	bootstrapSettingURL         = "server-url"
 	bootstrapSettingTelemetry   = "telemetry-opt"
 )
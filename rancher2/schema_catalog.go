@@ -5,6 +5,17 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+const (
+	catalogConditionRefreshed = "Refreshed"
+	catalogScopeCluster       = "cluster"
+	catalogScopeGlobal        = "global"
+	catalogScopeProject       = "project"
+)
+
+var (
+	catalogScopes = []string{catalogScopeCluster, catalogScopeGlobal, catalogScopeProject}
+)
+
 // Shemas
 
 func catalogFields() map[string]*schema.Schema {
@@ -33,6 +44,36 @@ func catalogFields() map[string]*schema.Schema {
 			Optional: true,
 			Default:  "master",
 		},
+		"username": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"password": &schema.Schema{
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"refresh": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"scope": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      catalogScopeGlobal,
+			ValidateFunc: validation.StringInSlice(catalogScopes, false),
+		},
+		"cluster_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+		"project_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
 		"annotations": &schema.Schema{
 			Type:     schema.TypeMap,
 			Optional: true,
@@ -0,0 +1,64 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//Schemas
+
+func catalogTemplateFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"catalog_name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"cluster_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"project_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"categories": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"default_template_version_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"default_version": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"versions": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"annotations": &schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+		"labels": &schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+	}
+
+	return s
+}
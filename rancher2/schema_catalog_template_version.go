@@ -0,0 +1,108 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//Schemas
+
+func catalogTemplateVersionQuestionFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"default": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"group": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"label": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"options": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"required": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"show_if": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"variable": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	return s
+}
+
+func catalogTemplateVersionFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"catalog_name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"template_name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"cluster_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"project_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"version": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"default_answers": &schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+		"external_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"kube_version": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"questions": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: catalogTemplateVersionQuestionFields(),
+			},
+		},
+		"rancher_version": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"required_namespace": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	return s
+}
@@ -12,7 +12,8 @@ const (
 )
 
 var (
-	clusterDrivers = []string{clusterDriverImported, clusterDriverAKS, clusterDriverEKS, clusterDriverGKE, clusterDriverRKE}
+	clusterDrivers                 = []string{clusterDriverImported, clusterDriverAKS, clusterDriverEKS, clusterDriverGKE, clusterDriverRKE}
+	clusterMonitoringPendingStates = []string{"pending", "provisioning", "waiting"}
 )
 
 //Types
@@ -131,6 +132,24 @@ func clusterFields() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"default_pod_security_policy_template_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"cluster_monitoring_input": &schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: clusterMonitoringInputFields(),
+			},
+		},
+		"enable_cluster_monitoring": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 		"cluster_registration_token": &schema.Schema{
 			Type:     schema.TypeList,
 			MaxItems: 1,
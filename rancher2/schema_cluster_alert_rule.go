@@ -0,0 +1,124 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	alertRuleSeverityCritical = "critical"
+	alertRuleSeverityInfo     = "info"
+	alertRuleSeverityWarning  = "warning"
+
+	alertRuleStateActive   = "active"
+	alertRuleStateInactive = "inactive"
+	alertRuleStateMuted    = "muted"
+)
+
+var (
+	alertRuleSeverities = []string{alertRuleSeverityCritical, alertRuleSeverityInfo, alertRuleSeverityWarning}
+)
+
+// Shemas
+
+func clusterAlertRuleFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"cluster_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"group_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"enabled": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"event_rule": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"metric_rule", "node_rule", "system_service_rule"},
+			Elem: &schema.Resource{
+				Schema: alertEventRuleFields(),
+			},
+		},
+		"group_interval_seconds": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertGroupIntervalSecondsDefault,
+		},
+		"group_wait_seconds": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertGroupWaitSecondsDefault,
+		},
+		"inherited": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"metric_rule": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"event_rule", "node_rule", "system_service_rule"},
+			Elem: &schema.Resource{
+				Schema: alertMetricRuleFields(),
+			},
+		},
+		"muted": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"node_rule": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"event_rule", "metric_rule", "system_service_rule"},
+			Elem: &schema.Resource{
+				Schema: alertNodeRuleFields(),
+			},
+		},
+		"repeat_interval_seconds": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertRepeatIntervalSecondsDefault,
+		},
+		"severity": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      alertRuleSeverityCritical,
+			ValidateFunc: validation.StringInSlice(alertRuleSeverities, false),
+		},
+		"system_service_rule": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"event_rule", "metric_rule", "node_rule"},
+			Elem: &schema.Resource{
+				Schema: alertSystemServiceRuleFields(),
+			},
+		},
+		"annotations": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"labels": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,30 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+//Types
+
+type MonitoringInput struct {
+	managementClient.MonitoringInput
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+}
+
+//Schemas
+
+func clusterMonitoringInputFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"answers": {
+			Type:     schema.TypeMap,
+			Optional: true,
+		},
+		"version": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,58 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	globalDNSTTLDefault = 300
+)
+
+// Shemas
+
+func globalDNSFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"fqdn": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"provider_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"multi_cluster_app_id": &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"project_ids"},
+		},
+		"project_ids": &schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"multi_cluster_app_id"},
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ttl": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  globalDNSTTLDefault,
+		},
+		"annotations": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"labels": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,59 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Shemas
+
+func globalDNSProviderFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"root_domain": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"alidns_config": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"cloudflare_config", "route53_config"},
+			Elem: &schema.Resource{
+				Schema: globalDNSProviderAlidnsConfigFields(),
+			},
+		},
+		"cloudflare_config": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"alidns_config", "route53_config"},
+			Elem: &schema.Resource{
+				Schema: globalDNSProviderCloudflareConfigFields(),
+			},
+		},
+		"route53_config": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"alidns_config", "cloudflare_config"},
+			Elem: &schema.Resource{
+				Schema: globalDNSProviderRoute53ConfigFields(),
+			},
+		},
+		"annotations": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"labels": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	return s
+}
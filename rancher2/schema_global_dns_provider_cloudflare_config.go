@@ -0,0 +1,28 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//Schemas
+
+func globalDNSProviderCloudflareConfigFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"api_email": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"api_key": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+		"proxy_setting": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+
+	return s
+}
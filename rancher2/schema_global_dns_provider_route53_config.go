@@ -0,0 +1,55 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	globalDNSProviderRoute53CredentialsPathDefault = "/.aws"
+	globalDNSProviderRoute53RegionDefault          = "us-west-2"
+	globalDNSProviderRoute53ZoneTypePrivate        = "private"
+	globalDNSProviderRoute53ZoneTypePublic         = "public"
+)
+
+var (
+	globalDNSProviderRoute53ZoneTypes = []string{globalDNSProviderRoute53ZoneTypePrivate, globalDNSProviderRoute53ZoneTypePublic}
+)
+
+//Schemas
+
+func globalDNSProviderRoute53ConfigFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"access_key": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"secret_key": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+		"credentials_path": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  globalDNSProviderRoute53CredentialsPathDefault,
+		},
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  globalDNSProviderRoute53RegionDefault,
+		},
+		"role_arn": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"zone_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      globalDNSProviderRoute53ZoneTypePublic,
+			ValidateFunc: validation.StringInSlice(globalDNSProviderRoute53ZoneTypes, false),
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,198 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	multiClusterAppTemplateVersionIDPrefix = "cattle-global-data:"
+)
+
+var (
+	multiClusterAppMemberAccessTypes = []string{"owner", "member", "read-only"}
+)
+
+//Schemas
+
+func multiClusterAppAnswerFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"cluster_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"project_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"values": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+		},
+	}
+
+	return s
+}
+
+func multiClusterAppMemberFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"access_type": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "owner",
+			ValidateFunc: validation.StringInSlice(multiClusterAppMemberAccessTypes, false),
+		},
+		"group_principal_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"user_principal_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	return s
+}
+
+func multiClusterAppTargetFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"app_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"health_state": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"state": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	return s
+}
+
+func multiClusterAppRollingUpdateFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"batch_size": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
+		},
+		"interval": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
+		},
+	}
+
+	return s
+}
+
+func multiClusterAppUpgradeStrategyFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"rolling_update": &schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: multiClusterAppRollingUpdateFields(),
+			},
+		},
+	}
+
+	return s
+}
+
+func multiClusterAppFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"catalog_name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"targets": &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: multiClusterAppTargetFields(),
+			},
+		},
+		"template_name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"template_version": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"answers": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: multiClusterAppAnswerFields(),
+			},
+		},
+		"members": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: multiClusterAppMemberFields(),
+			},
+		},
+		"revision_history_limit": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
+		},
+		"roles": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"upgrade_strategy": &schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: multiClusterAppUpgradeStrategyFields(),
+			},
+		},
+		"revision_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"template_version_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"annotations": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"labels": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	return s
+}
@@ -6,6 +6,29 @@ import (
 
 //Schemas
 
+func namespaceContainerResourceLimitFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"limits_cpu": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"limits_memory": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"requests_cpu": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"requests_memory": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	return s
+}
+
 func namespaceResourceQuotaLimitFields() map[string]*schema.Schema {
 	s := map[string]*schema.Schema{
 		"config_maps": {
@@ -87,7 +110,8 @@ func namespaceFields() map[string]*schema.Schema {
 			Required:    true,
 			Description: "Project ID where k8s namespace belongs",
 			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-				if old == "" || new == "" {
+				// Interpolated project ID is not known until apply, e.g. project created on same apply
+				if old == "" || new == "" || isUnknownValue(new) {
 					return false
 				}
 				oldClusterID, oldProjectID := splitProjectID(old)
@@ -110,6 +134,15 @@ func namespaceFields() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Description of the k8s namespace managed by rancher v2",
 		},
+		"container_resource_limit": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: namespaceContainerResourceLimitFields(),
+			},
+		},
 		"resource_quota": {
 			Type:     schema.TypeList,
 			MaxItems: 1,
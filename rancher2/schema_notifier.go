@@ -0,0 +1,87 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Shemas
+
+func notifierFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"cluster_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"pagerduty_config": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"slack_config", "smtp_config", "webhook_config", "wechat_config"},
+			Elem: &schema.Resource{
+				Schema: notifierPagerdutyConfigFields(),
+			},
+		},
+		"send_test": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"slack_config": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"pagerduty_config", "smtp_config", "webhook_config", "wechat_config"},
+			Elem: &schema.Resource{
+				Schema: notifierSlackConfigFields(),
+			},
+		},
+		"smtp_config": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"pagerduty_config", "slack_config", "webhook_config", "wechat_config"},
+			Elem: &schema.Resource{
+				Schema: notifierSMTPConfigFields(),
+			},
+		},
+		"webhook_config": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"pagerduty_config", "slack_config", "smtp_config", "wechat_config"},
+			Elem: &schema.Resource{
+				Schema: notifierWebhookConfigFields(),
+			},
+		},
+		"wechat_config": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"pagerduty_config", "slack_config", "smtp_config", "webhook_config"},
+			Elem: &schema.Resource{
+				Schema: notifierWechatConfigFields(),
+			},
+		},
+		"annotations": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"labels": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	return s
+}
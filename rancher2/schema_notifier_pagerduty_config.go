@@ -0,0 +1,19 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//Schemas
+
+func notifierPagerdutyConfigFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"service_key": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+	}
+
+	return s
+}
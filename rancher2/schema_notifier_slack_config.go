@@ -0,0 +1,22 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//Schemas
+
+func notifierSlackConfigFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"default_recipient": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"url": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,44 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//Schemas
+
+func notifierSMTPConfigFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"default_recipient": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"host": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"port": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"sender": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"tls": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"username": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,18 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//Schemas
+
+func notifierWebhookConfigFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"url": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	return s
+}
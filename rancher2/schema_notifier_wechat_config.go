@@ -0,0 +1,48 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	notifierWechatRecipientTypeParty = "party"
+	notifierWechatRecipientTypeTag   = "tag"
+	notifierWechatRecipientTypeUser  = "user"
+)
+
+var (
+	notifierWechatRecipientTypes = []string{notifierWechatRecipientTypeParty, notifierWechatRecipientTypeTag, notifierWechatRecipientTypeUser}
+)
+
+//Schemas
+
+func notifierWechatConfigFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"agent": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"corp": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"default_recipient": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"secret": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+		"recipient_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      notifierWechatRecipientTypeParty,
+			ValidateFunc: validation.StringInSlice(notifierWechatRecipientTypes, false),
+		},
+	}
+
+	return s
+}
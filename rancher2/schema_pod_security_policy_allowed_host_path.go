@@ -0,0 +1,23 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//Schemas
+
+func podSecurityPolicyAllowedHostPathsFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"path_prefix": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"read_only": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,38 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	podSecurityPolicyFSGroupRuleMayRunAs  = "MayRunAs"
+	podSecurityPolicyFSGroupRuleMustRunAs = "MustRunAs"
+	podSecurityPolicyFSGroupRuleRunAsAny  = "RunAsAny"
+)
+
+var (
+	podSecurityPolicyFSGroupRules = []string{podSecurityPolicyFSGroupRuleMayRunAs, podSecurityPolicyFSGroupRuleMustRunAs, podSecurityPolicyFSGroupRuleRunAsAny}
+)
+
+//Schemas
+
+func podSecurityPolicyFSGroupFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"range": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: podSecurityPolicyIDRangesFields(),
+			},
+		},
+		"rule": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(podSecurityPolicyFSGroupRules, false),
+		},
+	}
+
+	return s
+}
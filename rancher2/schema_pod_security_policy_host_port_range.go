@@ -0,0 +1,25 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+//Schemas
+
+func podSecurityPolicyHostPortRangesFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"max": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+		},
+		"min": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,22 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//Schemas
+
+func podSecurityPolicyIDRangesFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"max": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"min": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+	}
+
+	return s
+}
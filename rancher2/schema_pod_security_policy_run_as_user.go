@@ -0,0 +1,38 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	podSecurityPolicyRunAsUserRuleMustRunAs        = "MustRunAs"
+	podSecurityPolicyRunAsUserRuleMustRunAsNonRoot = "MustRunAsNonRoot"
+	podSecurityPolicyRunAsUserRuleRunAsAny         = "RunAsAny"
+)
+
+var (
+	podSecurityPolicyRunAsUserRules = []string{podSecurityPolicyRunAsUserRuleMustRunAs, podSecurityPolicyRunAsUserRuleMustRunAsNonRoot, podSecurityPolicyRunAsUserRuleRunAsAny}
+)
+
+//Schemas
+
+func podSecurityPolicyRunAsUserFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"range": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: podSecurityPolicyIDRangesFields(),
+			},
+		},
+		"rule": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(podSecurityPolicyRunAsUserRules, false),
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,61 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	podSecurityPolicySELinuxRuleMustRunAs = "MustRunAs"
+	podSecurityPolicySELinuxRuleRunAsAny  = "RunAsAny"
+)
+
+var (
+	podSecurityPolicySELinuxRules = []string{podSecurityPolicySELinuxRuleMustRunAs, podSecurityPolicySELinuxRuleRunAsAny}
+)
+
+//Schemas
+
+func podSecurityPolicySELinuxOptionsFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"level": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"role": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"user": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	return s
+}
+
+func podSecurityPolicySELinuxFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"rule": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(podSecurityPolicySELinuxRules, false),
+		},
+		"se_linux_option": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: podSecurityPolicySELinuxOptionsFields(),
+			},
+		},
+	}
+
+	return s
+}
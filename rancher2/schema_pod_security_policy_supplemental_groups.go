@@ -0,0 +1,38 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	podSecurityPolicySupplementalGroupsRuleMayRunAs  = "MayRunAs"
+	podSecurityPolicySupplementalGroupsRuleMustRunAs = "MustRunAs"
+	podSecurityPolicySupplementalGroupsRuleRunAsAny  = "RunAsAny"
+)
+
+var (
+	podSecurityPolicySupplementalGroupsRules = []string{podSecurityPolicySupplementalGroupsRuleMayRunAs, podSecurityPolicySupplementalGroupsRuleMustRunAs, podSecurityPolicySupplementalGroupsRuleRunAsAny}
+)
+
+//Schemas
+
+func podSecurityPolicySupplementalGroupsFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"range": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: podSecurityPolicyIDRangesFields(),
+			},
+		},
+		"rule": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(podSecurityPolicySupplementalGroupsRules, false),
+		},
+	}
+
+	return s
+}
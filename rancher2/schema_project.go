@@ -6,6 +6,29 @@ import (
 
 //Schemas
 
+func projectContainerResourceLimitFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"limits_cpu": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"limits_memory": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"requests_cpu": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"requests_memory": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	return s
+}
+
 func projectResourceQuotaLimitFields() map[string]*schema.Schema {
 	s := map[string]*schema.Schema{
 		"config_maps": {
@@ -103,6 +126,19 @@ func projectFields() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"pod_security_policy_template_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"container_resource_limit": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: projectContainerResourceLimitFields(),
+			},
+		},
 		"resource_quota": {
 			Type:     schema.TypeList,
 			MaxItems: 1,
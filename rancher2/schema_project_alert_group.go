@@ -0,0 +1,59 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Shemas
+
+func projectAlertGroupFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"group_interval_seconds": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertGroupIntervalSecondsDefault,
+		},
+		"group_wait_seconds": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertGroupWaitSecondsDefault,
+		},
+		"recipients": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: alertRecipientFields(),
+			},
+		},
+		"repeat_interval_seconds": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertRepeatIntervalSecondsDefault,
+		},
+		"annotations": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"labels": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	return s
+}
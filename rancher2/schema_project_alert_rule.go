@@ -0,0 +1,101 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Shemas
+
+func projectAlertRuleFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"project_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"group_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"enabled": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"group_interval_seconds": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertGroupIntervalSecondsDefault,
+		},
+		"group_wait_seconds": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertGroupWaitSecondsDefault,
+		},
+		"inherited": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"metric_rule": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"pod_rule", "workload_rule"},
+			Elem: &schema.Resource{
+				Schema: alertMetricRuleFields(),
+			},
+		},
+		"muted": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"pod_rule": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"metric_rule", "workload_rule"},
+			Elem: &schema.Resource{
+				Schema: alertPodRuleFields(),
+			},
+		},
+		"repeat_interval_seconds": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  alertRepeatIntervalSecondsDefault,
+		},
+		"severity": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      alertRuleSeverityCritical,
+			ValidateFunc: validation.StringInSlice(alertRuleSeverities, false),
+		},
+		"workload_rule": &schema.Schema{
+			Type:          schema.TypeList,
+			MaxItems:      1,
+			Optional:      true,
+			ConflictsWith: []string{"metric_rule", "pod_rule"},
+			Elem: &schema.Resource{
+				Schema: alertWorkloadRuleFields(),
+			},
+		},
+		"annotations": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"labels": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	return s
+}
@@ -0,0 +1,27 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+//Schemas
+
+func registryCredentialFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"address": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"username": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	return s
+}
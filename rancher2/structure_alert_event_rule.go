@@ -0,0 +1,39 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenAlertEventRule(in *managementClient.EventRule) []interface{} {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return []interface{}{}
+	}
+
+	obj["event_type"] = in.EventType
+	obj["resource_kind"] = in.ResourceKind
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandAlertEventRule(p []interface{}) *managementClient.EventRule {
+	obj := &managementClient.EventRule{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["event_type"].(string); ok && len(v) > 0 {
+		obj.EventType = v
+	}
+
+	if v, ok := in["resource_kind"].(string); ok && len(v) > 0 {
+		obj.ResourceKind = v
+	}
+
+	return obj
+}
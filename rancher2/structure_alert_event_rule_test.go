@@ -0,0 +1,68 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testAlertEventRuleConf      *managementClient.EventRule
+	testAlertEventRuleInterface []interface{}
+)
+
+func init() {
+	testAlertEventRuleConf = &managementClient.EventRule{
+		EventType:    alertEventRuleTypeWarning,
+		ResourceKind: "Pod",
+	}
+	testAlertEventRuleInterface = []interface{}{
+		map[string]interface{}{
+			"event_type":    alertEventRuleTypeWarning,
+			"resource_kind": "Pod",
+		},
+	}
+}
+
+func TestFlattenAlertEventRule(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.EventRule
+		ExpectedOutput []interface{}
+	}{
+		{
+			testAlertEventRuleConf,
+			testAlertEventRuleInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenAlertEventRule(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandAlertEventRule(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.EventRule
+	}{
+		{
+			testAlertEventRuleInterface,
+			testAlertEventRuleConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandAlertEventRule(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
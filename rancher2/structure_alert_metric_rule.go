@@ -0,0 +1,60 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenAlertMetricRule(in *managementClient.MetricRule) []interface{} {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return []interface{}{}
+	}
+
+	obj["duration"] = in.Duration
+	obj["expression"] = in.Expression
+	obj["threshold_value"] = in.ThresholdValue
+
+	if len(in.Comparison) > 0 {
+		obj["comparison"] = in.Comparison
+	}
+
+	if len(in.Description) > 0 {
+		obj["description"] = in.Description
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandAlertMetricRule(p []interface{}) *managementClient.MetricRule {
+	obj := &managementClient.MetricRule{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["duration"].(string); ok && len(v) > 0 {
+		obj.Duration = v
+	}
+
+	if v, ok := in["expression"].(string); ok && len(v) > 0 {
+		obj.Expression = v
+	}
+
+	if v, ok := in["threshold_value"].(float64); ok {
+		obj.ThresholdValue = v
+	}
+
+	if v, ok := in["comparison"].(string); ok && len(v) > 0 {
+		obj.Comparison = v
+	}
+
+	if v, ok := in["description"].(string); ok && len(v) > 0 {
+		obj.Description = v
+	}
+
+	return obj
+}
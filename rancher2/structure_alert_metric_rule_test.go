@@ -0,0 +1,74 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testAlertMetricRuleConf      *managementClient.MetricRule
+	testAlertMetricRuleInterface []interface{}
+)
+
+func init() {
+	testAlertMetricRuleConf = &managementClient.MetricRule{
+		Comparison:     "greater-than",
+		Description:    "description",
+		Duration:       "5m",
+		Expression:     "cluster:cpu_usage",
+		ThresholdValue: 0.8,
+	}
+	testAlertMetricRuleInterface = []interface{}{
+		map[string]interface{}{
+			"comparison":      "greater-than",
+			"description":     "description",
+			"duration":        "5m",
+			"expression":      "cluster:cpu_usage",
+			"threshold_value": 0.8,
+		},
+	}
+}
+
+func TestFlattenAlertMetricRule(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.MetricRule
+		ExpectedOutput []interface{}
+	}{
+		{
+			testAlertMetricRuleConf,
+			testAlertMetricRuleInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenAlertMetricRule(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandAlertMetricRule(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.MetricRule
+	}{
+		{
+			testAlertMetricRuleInterface,
+			testAlertMetricRuleConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandAlertMetricRule(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenAlertNodeRule(in *managementClient.NodeRule) []interface{} {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return []interface{}{}
+	}
+
+	obj["condition"] = in.Condition
+	obj["cpu_threshold"] = int(in.CPUThreshold)
+	obj["mem_threshold"] = int(in.MemThreshold)
+
+	if len(in.NodeID) > 0 {
+		obj["node_id"] = in.NodeID
+	}
+
+	if len(in.Selector) > 0 {
+		obj["selector"] = toMapInterface(in.Selector)
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandAlertNodeRule(p []interface{}) *managementClient.NodeRule {
+	obj := &managementClient.NodeRule{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["condition"].(string); ok && len(v) > 0 {
+		obj.Condition = v
+	}
+
+	if v, ok := in["cpu_threshold"].(int); ok && v > 0 {
+		obj.CPUThreshold = int64(v)
+	}
+
+	if v, ok := in["mem_threshold"].(int); ok && v > 0 {
+		obj.MemThreshold = int64(v)
+	}
+
+	if v, ok := in["node_id"].(string); ok && len(v) > 0 {
+		obj.NodeID = v
+	}
+
+	if v, ok := in["selector"].(map[string]interface{}); ok && len(v) > 0 {
+		obj.Selector = toMapString(v)
+	}
+
+	return obj
+}
@@ -0,0 +1,76 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testAlertNodeRuleConf      *managementClient.NodeRule
+	testAlertNodeRuleInterface []interface{}
+)
+
+func init() {
+	testAlertNodeRuleConf = &managementClient.NodeRule{
+		Condition:    alertNodeRuleConditionCPU,
+		CPUThreshold: 80,
+		MemThreshold: 70,
+		Selector: map[string]string{
+			"role": "worker",
+		},
+	}
+	testAlertNodeRuleInterface = []interface{}{
+		map[string]interface{}{
+			"condition":     alertNodeRuleConditionCPU,
+			"cpu_threshold": 80,
+			"mem_threshold": 70,
+			"selector": map[string]interface{}{
+				"role": "worker",
+			},
+		},
+	}
+}
+
+func TestFlattenAlertNodeRule(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.NodeRule
+		ExpectedOutput []interface{}
+	}{
+		{
+			testAlertNodeRuleConf,
+			testAlertNodeRuleInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenAlertNodeRule(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandAlertNodeRule(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.NodeRule
+	}{
+		{
+			testAlertNodeRuleInterface,
+			testAlertNodeRuleConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandAlertNodeRule(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenAlertPodRule(in *managementClient.PodRule) []interface{} {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return []interface{}{}
+	}
+
+	obj["pod_id"] = in.PodID
+	obj["condition"] = in.Condition
+	obj["restart_interval_seconds"] = int(in.RestartIntervalSeconds)
+	obj["restart_times"] = int(in.RestartTimes)
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandAlertPodRule(p []interface{}) *managementClient.PodRule {
+	obj := &managementClient.PodRule{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	obj.PodID = in["pod_id"].(string)
+
+	if v, ok := in["condition"].(string); ok && len(v) > 0 {
+		obj.Condition = v
+	}
+
+	if v, ok := in["restart_interval_seconds"].(int); ok && v > 0 {
+		obj.RestartIntervalSeconds = int64(v)
+	}
+
+	if v, ok := in["restart_times"].(int); ok && v > 0 {
+		obj.RestartTimes = int64(v)
+	}
+
+	return obj
+}
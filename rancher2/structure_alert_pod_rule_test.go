@@ -0,0 +1,72 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testAlertPodRuleConf      *managementClient.PodRule
+	testAlertPodRuleInterface []interface{}
+)
+
+func init() {
+	testAlertPodRuleConf = &managementClient.PodRule{
+		PodID:                  "c-test:p-test:pod",
+		Condition:              alertPodRuleConditionRestarts,
+		RestartIntervalSeconds: 600,
+		RestartTimes:           5,
+	}
+	testAlertPodRuleInterface = []interface{}{
+		map[string]interface{}{
+			"pod_id":                   "c-test:p-test:pod",
+			"condition":                alertPodRuleConditionRestarts,
+			"restart_interval_seconds": 600,
+			"restart_times":            5,
+		},
+	}
+}
+
+func TestFlattenAlertPodRule(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.PodRule
+		ExpectedOutput []interface{}
+	}{
+		{
+			testAlertPodRuleConf,
+			testAlertPodRuleInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenAlertPodRule(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandAlertPodRule(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.PodRule
+	}{
+		{
+			testAlertPodRuleInterface,
+			testAlertPodRuleConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandAlertPodRule(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
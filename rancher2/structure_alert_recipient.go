@@ -0,0 +1,51 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenAlertRecipients(p []managementClient.Recipient) []interface{} {
+	out := make([]interface{}, len(p))
+	for i, in := range p {
+		obj := make(map[string]interface{})
+
+		obj["notifier_id"] = in.NotifierID
+		obj["notifier_type"] = in.NotifierType
+
+		if len(in.Recipient) > 0 {
+			obj["recipient"] = in.Recipient
+		}
+
+		out[i] = obj
+	}
+
+	return out
+}
+
+// Expanders
+
+func expandAlertRecipients(p []interface{}) []managementClient.Recipient {
+	out := make([]managementClient.Recipient, len(p))
+	for i, v := range p {
+		in := v.(map[string]interface{})
+		obj := managementClient.Recipient{}
+
+		if v, ok := in["notifier_id"].(string); ok && len(v) > 0 {
+			obj.NotifierID = v
+		}
+
+		if v, ok := in["notifier_type"].(string); ok && len(v) > 0 {
+			obj.NotifierType = v
+		}
+
+		if v, ok := in["recipient"].(string); ok && len(v) > 0 {
+			obj.Recipient = v
+		}
+
+		out[i] = obj
+	}
+
+	return out
+}
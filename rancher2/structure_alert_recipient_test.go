@@ -0,0 +1,80 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testAlertRecipientsConf      []managementClient.Recipient
+	testAlertRecipientsInterface []interface{}
+)
+
+func init() {
+	testAlertRecipientsConf = []managementClient.Recipient{
+		{
+			NotifierID:   "c-test:n-slack",
+			NotifierType: alertRecipientTypeSlack,
+			Recipient:    "#foo",
+		},
+		{
+			NotifierID:   "c-test:n-smtp",
+			NotifierType: alertRecipientTypeEmail,
+		},
+	}
+	testAlertRecipientsInterface = []interface{}{
+		map[string]interface{}{
+			"notifier_id":   "c-test:n-slack",
+			"notifier_type": alertRecipientTypeSlack,
+			"recipient":     "#foo",
+		},
+		map[string]interface{}{
+			"notifier_id":   "c-test:n-smtp",
+			"notifier_type": alertRecipientTypeEmail,
+		},
+	}
+}
+
+func TestFlattenAlertRecipients(t *testing.T) {
+
+	cases := []struct {
+		Input          []managementClient.Recipient
+		ExpectedOutput []interface{}
+	}{
+		{
+			testAlertRecipientsConf,
+			testAlertRecipientsInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenAlertRecipients(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandAlertRecipients(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput []managementClient.Recipient
+	}{
+		{
+			testAlertRecipientsInterface,
+			testAlertRecipientsConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandAlertRecipients(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenAlertSystemServiceRule(in *managementClient.SystemServiceRule) []interface{} {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return []interface{}{}
+	}
+
+	obj["condition"] = in.Condition
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandAlertSystemServiceRule(p []interface{}) *managementClient.SystemServiceRule {
+	obj := &managementClient.SystemServiceRule{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["condition"].(string); ok && len(v) > 0 {
+		obj.Condition = v
+	}
+
+	return obj
+}
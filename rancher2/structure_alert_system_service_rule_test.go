@@ -0,0 +1,66 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testAlertSystemServiceRuleConf      *managementClient.SystemServiceRule
+	testAlertSystemServiceRuleInterface []interface{}
+)
+
+func init() {
+	testAlertSystemServiceRuleConf = &managementClient.SystemServiceRule{
+		Condition: "etcd",
+	}
+	testAlertSystemServiceRuleInterface = []interface{}{
+		map[string]interface{}{
+			"condition": "etcd",
+		},
+	}
+}
+
+func TestFlattenAlertSystemServiceRule(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.SystemServiceRule
+		ExpectedOutput []interface{}
+	}{
+		{
+			testAlertSystemServiceRuleConf,
+			testAlertSystemServiceRuleInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenAlertSystemServiceRule(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandAlertSystemServiceRule(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.SystemServiceRule
+	}{
+		{
+			testAlertSystemServiceRuleInterface,
+			testAlertSystemServiceRuleConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandAlertSystemServiceRule(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
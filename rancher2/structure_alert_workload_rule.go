@@ -0,0 +1,50 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenAlertWorkloadRule(in *managementClient.WorkloadRule) []interface{} {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return []interface{}{}
+	}
+
+	obj["available_percentage"] = int(in.AvailablePercentage)
+
+	if len(in.Selector) > 0 {
+		obj["selector"] = toMapInterface(in.Selector)
+	}
+
+	if len(in.WorkloadID) > 0 {
+		obj["workload_id"] = in.WorkloadID
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandAlertWorkloadRule(p []interface{}) *managementClient.WorkloadRule {
+	obj := &managementClient.WorkloadRule{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["available_percentage"].(int); ok && v > 0 {
+		obj.AvailablePercentage = int64(v)
+	}
+
+	if v, ok := in["selector"].(map[string]interface{}); ok && len(v) > 0 {
+		obj.Selector = toMapString(v)
+	}
+
+	if v, ok := in["workload_id"].(string); ok && len(v) > 0 {
+		obj.WorkloadID = v
+	}
+
+	return obj
+}
@@ -0,0 +1,72 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testAlertWorkloadRuleConf      *managementClient.WorkloadRule
+	testAlertWorkloadRuleInterface []interface{}
+)
+
+func init() {
+	testAlertWorkloadRuleConf = &managementClient.WorkloadRule{
+		AvailablePercentage: 50,
+		Selector: map[string]string{
+			"app": "test",
+		},
+	}
+	testAlertWorkloadRuleInterface = []interface{}{
+		map[string]interface{}{
+			"available_percentage": 50,
+			"selector": map[string]interface{}{
+				"app": "test",
+			},
+		},
+	}
+}
+
+func TestFlattenAlertWorkloadRule(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.WorkloadRule
+		ExpectedOutput []interface{}
+	}{
+		{
+			testAlertWorkloadRuleConf,
+			testAlertWorkloadRuleInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenAlertWorkloadRule(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandAlertWorkloadRule(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.WorkloadRule
+	}{
+		{
+			testAlertWorkloadRuleInterface,
+			testAlertWorkloadRuleConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandAlertWorkloadRule(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package rancher2
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+// Flatteners
+
+func flattenApp(d *schema.ResourceData, in *projectClient.App) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("name", in.Name)
+	d.Set("target_namespace", in.TargetNamespace)
+	d.Set("description", in.Description)
+	d.Set("prune", in.Prune)
+	d.Set("values_yaml", in.ValuesYaml)
+	d.Set("external_id", in.ExternalID)
+	d.Set("revision_id", in.AppRevisionID)
+
+	if len(in.ProjectID) > 0 {
+		d.Set("project_id", in.ProjectID)
+	}
+
+	catalog, template, version, err := splitAppExternalID(in.ExternalID)
+	if err != nil {
+		return err
+	}
+	d.Set("catalog_name", catalog)
+	d.Set("template_name", template)
+	d.Set("template_version", version)
+
+	err = d.Set("answers", toMapInterface(in.Answers))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Expanders
+
+func expandApp(in *schema.ResourceData) *projectClient.App {
+	obj := &projectClient.App{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.Name = in.Get("name").(string)
+	obj.ProjectID = in.Get("project_id").(string)
+	obj.TargetNamespace = in.Get("target_namespace").(string)
+	obj.ExternalID = expandAppExternalID(in)
+	obj.Description = in.Get("description").(string)
+	obj.Prune = in.Get("prune").(bool)
+	obj.ValuesYaml = in.Get("values_yaml").(string)
+
+	if v, ok := in.Get("answers").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Answers = toMapString(v)
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
+
+func expandAppUpgrade(in *schema.ResourceData) *projectClient.AppUpgradeConfig {
+	obj := &projectClient.AppUpgradeConfig{}
+	if in == nil {
+		return nil
+	}
+
+	obj.ExternalID = expandAppExternalID(in)
+	obj.ForceUpgrade = in.Get("force_upgrade").(bool)
+	obj.ValuesYaml = in.Get("values_yaml").(string)
+
+	if v, ok := in.Get("answers").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Answers = toMapString(v)
+	}
+
+	return obj
+}
+
+func expandAppExternalID(in *schema.ResourceData) string {
+	return buildAppExternalID(in.Get("catalog_name").(string), in.Get("template_name").(string), in.Get("template_version").(string))
+}
+
+func buildAppExternalID(catalog, template, version string) string {
+	values := url.Values{}
+	values.Set("catalog", catalog)
+	if catalogType := appCatalogType(catalog); len(catalogType) > 0 {
+		values.Set("type", catalogType)
+	}
+	values.Set("template", template)
+	values.Set("version", version)
+
+	return appExternalIDPrefix + values.Encode()
+}
+
+// appCatalogType returns the external ID catalog type of cluster catalogs, <cluster_id>:<name>, and project catalogs, <project_id>:<name>.
+// Global catalogs have no type
+func appCatalogType(catalog string) string {
+	i := strings.Index(catalog, clusterProjectIDSeparator)
+	if i < 0 {
+		return ""
+	}
+	if strings.HasPrefix(catalog[:i], "p-") {
+		return appCatalogTypeProject
+	}
+
+	return appCatalogTypeCluster
+}
+
+func splitAppExternalID(externalID string) (string, string, string, error) {
+	if externalID == "" {
+		return "", "", "", nil
+	}
+
+	if !strings.HasPrefix(externalID, appExternalIDPrefix) {
+		return "", "", "", fmt.Errorf("[ERROR] Bad app external ID format %s", externalID)
+	}
+
+	values, err := url.ParseQuery(strings.TrimPrefix(externalID, appExternalIDPrefix))
+	if err != nil {
+		return "", "", "", fmt.Errorf("[ERROR] Parsing app external ID %s: %v", externalID, err)
+	}
+
+	return values.Get("catalog"), values.Get("template"), values.Get("version"), nil
+}
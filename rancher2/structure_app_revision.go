@@ -0,0 +1,59 @@
+package rancher2
+
+import (
+	"sort"
+
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+// Flatteners
+
+func flattenAppRevision(in *projectClient.AppRevision, currentName string) (map[string]interface{}, error) {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return obj, nil
+	}
+
+	obj["id"] = in.ID
+	obj["name"] = in.Name
+	obj["created"] = in.Created
+	obj["current"] = len(currentName) > 0 && in.Name == currentName
+
+	if in.Status != nil {
+		obj["external_id"] = in.Status.ExternalID
+		obj["values_yaml"] = in.Status.ValuesYaml
+		obj["answers"] = toMapInterface(in.Status.Answers)
+
+		_, _, version, err := splitAppExternalID(in.Status.ExternalID)
+		if err != nil {
+			return nil, err
+		}
+		obj["template_version"] = version
+	}
+
+	return obj, nil
+}
+
+// flattenAppRevisions returns app revisions sorted by creation date, oldest first
+func flattenAppRevisions(in []projectClient.AppRevision, currentName string) ([]interface{}, error) {
+	if len(in) == 0 {
+		return []interface{}{}, nil
+	}
+
+	revisions := make([]projectClient.AppRevision, len(in))
+	copy(revisions, in)
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Created < revisions[j].Created
+	})
+
+	out := make([]interface{}, len(revisions))
+	for i := range revisions {
+		obj, err := flattenAppRevision(&revisions[i], currentName)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = obj
+	}
+
+	return out, nil
+}
@@ -0,0 +1,91 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+var (
+	testAppRevisionsConf      []projectClient.AppRevision
+	testAppRevisionsInterface []interface{}
+)
+
+func init() {
+	testAppRevisionsConf = []projectClient.AppRevision{
+		{
+			Name:    "apprevision-new",
+			Created: "2019-04-02T10:00:00Z",
+			Status: &projectClient.AppRevisionStatus{
+				ExternalID: "catalog://?catalog=library&template=docker-registry&version=1.8.1",
+				ValuesYaml: "replicaCount: 2\n",
+				Answers: map[string]string{
+					"replicaCount": "2",
+				},
+			},
+		},
+		{
+			Name:    "apprevision-old",
+			Created: "2019-04-01T10:00:00Z",
+			Status: &projectClient.AppRevisionStatus{
+				ExternalID: "catalog://?catalog=library&template=docker-registry&version=1.6.1",
+				Answers: map[string]string{
+					"replicaCount": "1",
+				},
+			},
+		},
+	}
+	testAppRevisionsConf[0].ID = "p-test:apprevision-new"
+	testAppRevisionsConf[1].ID = "p-test:apprevision-old"
+	testAppRevisionsInterface = []interface{}{
+		map[string]interface{}{
+			"id":               "p-test:apprevision-old",
+			"name":             "apprevision-old",
+			"created":          "2019-04-01T10:00:00Z",
+			"current":          false,
+			"external_id":      "catalog://?catalog=library&template=docker-registry&version=1.6.1",
+			"template_version": "1.6.1",
+			"values_yaml":      "",
+			"answers": map[string]interface{}{
+				"replicaCount": "1",
+			},
+		},
+		map[string]interface{}{
+			"id":               "p-test:apprevision-new",
+			"name":             "apprevision-new",
+			"created":          "2019-04-02T10:00:00Z",
+			"current":          true,
+			"external_id":      "catalog://?catalog=library&template=docker-registry&version=1.8.1",
+			"template_version": "1.8.1",
+			"values_yaml":      "replicaCount: 2\n",
+			"answers": map[string]interface{}{
+				"replicaCount": "2",
+			},
+		},
+	}
+}
+
+func TestFlattenAppRevisions(t *testing.T) {
+
+	cases := []struct {
+		Input          []projectClient.AppRevision
+		ExpectedOutput []interface{}
+	}{
+		{
+			testAppRevisionsConf,
+			testAppRevisionsInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := flattenAppRevisions(tc.Input, "apprevision-new")
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+// Expanders
+
+func expandAppRollback(in *schema.ResourceData) *projectClient.RollbackRevision {
+	obj := &projectClient.RollbackRevision{}
+	if in == nil {
+		return nil
+	}
+
+	obj.RevisionID = in.Get("revision_id").(string)
+	obj.ForceUpgrade = in.Get("force_upgrade").(bool)
+
+	return obj
+}
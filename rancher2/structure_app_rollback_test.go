@@ -0,0 +1,49 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+var (
+	testAppRollbackConf      *projectClient.RollbackRevision
+	testAppRollbackInterface map[string]interface{}
+)
+
+func init() {
+	testAppRollbackConf = &projectClient.RollbackRevision{
+		RevisionID:   "p-test:apprevision-old",
+		ForceUpgrade: true,
+	}
+	testAppRollbackInterface = map[string]interface{}{
+		"project_id":    "c-test:p-test",
+		"app_id":        "p-test:foo",
+		"revision_id":   "p-test:apprevision-old",
+		"force_upgrade": true,
+	}
+}
+
+func TestExpandAppRollback(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *projectClient.RollbackRevision
+	}{
+		{
+			testAppRollbackInterface,
+			testAppRollbackConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, appRollbackFields(), tc.Input)
+		output := expandAppRollback(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+var (
+	testAppConf             *projectClient.App
+	testAppInterface        map[string]interface{}
+	testAppUpgradeConf      *projectClient.AppUpgradeConfig
+	testAppUpgradeInterface map[string]interface{}
+)
+
+func init() {
+	testAppConf = &projectClient.App{
+		Name:            "foo",
+		ProjectID:       "c-test:p-test",
+		TargetNamespace: "test",
+		ExternalID:      "catalog://?catalog=library&template=docker-registry&version=1.6.1",
+		Description:     "description",
+		Prune:           true,
+		ValuesYaml:      "replicaCount: 2\n",
+		Answers: map[string]string{
+			"replicaCount": "1",
+		},
+		Annotations: map[string]string{
+			"node_one": "one",
+			"node_two": "two",
+		},
+		Labels: map[string]string{
+			"option1": "value1",
+			"option2": "value2",
+		},
+	}
+	testAppInterface = map[string]interface{}{
+		"name":             "foo",
+		"project_id":       "c-test:p-test",
+		"target_namespace": "test",
+		"catalog_name":     "library",
+		"template_name":    "docker-registry",
+		"template_version": "1.6.1",
+		"description":      "description",
+		"prune":            true,
+		"values_yaml":      "replicaCount: 2\n",
+		"answers": map[string]interface{}{
+			"replicaCount": "1",
+		},
+		"annotations": map[string]interface{}{
+			"node_one": "one",
+			"node_two": "two",
+		},
+		"labels": map[string]interface{}{
+			"option1": "value1",
+			"option2": "value2",
+		},
+	}
+	testAppUpgradeConf = &projectClient.AppUpgradeConfig{
+		ExternalID:   "catalog://?catalog=library&template=docker-registry&version=1.8.1",
+		ForceUpgrade: true,
+		ValuesYaml:   "replicaCount: 2\n",
+		Answers: map[string]string{
+			"replicaCount": "1",
+		},
+	}
+	testAppUpgradeInterface = map[string]interface{}{
+		"name":             "foo",
+		"project_id":       "c-test:p-test",
+		"target_namespace": "test",
+		"catalog_name":     "library",
+		"template_name":    "docker-registry",
+		"template_version": "1.8.1",
+		"force_upgrade":    true,
+		"values_yaml":      "replicaCount: 2\n",
+		"answers": map[string]interface{}{
+			"replicaCount": "1",
+		},
+	}
+}
+
+func TestFlattenApp(t *testing.T) {
+
+	cases := []struct {
+		Input          *projectClient.App
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testAppConf,
+			testAppInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, appFields(), map[string]interface{}{})
+		err := flattenApp(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandApp(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *projectClient.App
+	}{
+		{
+			testAppInterface,
+			testAppConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, appFields(), tc.Input)
+		output := expandApp(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandAppUpgrade(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *projectClient.AppUpgradeConfig
+	}{
+		{
+			testAppUpgradeInterface,
+			testAppUpgradeConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, appFields(), tc.Input)
+		output := expandAppUpgrade(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestBuildAppExternalID(t *testing.T) {
+
+	cases := []struct {
+		Catalog        string
+		ExpectedOutput string
+	}{
+		{
+			"library",
+			"catalog://?catalog=library&template=docker-registry&version=1.6.1",
+		},
+		{
+			"c-test:foo",
+			"catalog://?catalog=c-test%3Afoo&template=docker-registry&type=clusterCatalog&version=1.6.1",
+		},
+		{
+			"p-test:foo",
+			"catalog://?catalog=p-test%3Afoo&template=docker-registry&type=projectCatalog&version=1.6.1",
+		},
+	}
+
+	for _, tc := range cases {
+		output := buildAppExternalID(tc.Catalog, "docker-registry", "1.6.1")
+		if output != tc.ExpectedOutput {
+			t.Fatalf("Unexpected output from buildAppExternalID.\nExpected: %s\nGiven:    %s",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestSplitAppExternalID(t *testing.T) {
+	catalog, template, version, err := splitAppExternalID(buildAppExternalID("c-test:foo", "docker-registry", "1.6.1"))
+	if err != nil {
+		t.Fatalf("[ERROR] splitting app external ID: %#v", err)
+	}
+	if catalog != "c-test:foo" || template != "docker-registry" || version != "1.6.1" {
+		t.Fatalf("Unexpected output from splitAppExternalID: %s %s %s", catalog, template, version)
+	}
+
+	_, _, _, err = splitAppExternalID("helm://docker-registry")
+	if err == nil {
+		t.Fatalf("Expected error splitting bad app external ID")
+	}
+}
@@ -1,41 +1,91 @@
 package rancher2
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	managementClient "github.com/rancher/types/client/management/v3"
 )
 
 // Flatteners
 
-func flattenCatalog(d *schema.ResourceData, in *managementClient.Catalog) error {
-	if in == nil {
+func flattenCatalog(d *schema.ResourceData, in interface{}) error {
+	switch obj := in.(type) {
+	case *managementClient.Catalog:
+		if obj == nil {
+			return nil
+		}
+		d.SetId(obj.ID)
+		d.Set("scope", catalogScopeGlobal)
+		return flattenCatalogFields(d, obj.Name, obj.URL, obj.Description, obj.Kind, obj.Branch, obj.Username, obj.Password, obj.Annotations, obj.Labels)
+	case *managementClient.ClusterCatalog:
+		if obj == nil {
+			return nil
+		}
+		d.SetId(obj.ID)
+		d.Set("scope", catalogScopeCluster)
+		d.Set("cluster_id", obj.ClusterID)
+		return flattenCatalogFields(d, obj.Name, obj.URL, obj.Description, obj.Kind, obj.Branch, obj.Username, obj.Password, obj.Annotations, obj.Labels)
+	case *managementClient.ProjectCatalog:
+		if obj == nil {
+			return nil
+		}
+		d.SetId(obj.ID)
+		d.Set("scope", catalogScopeProject)
+		d.Set("project_id", obj.ProjectID)
+		return flattenCatalogFields(d, obj.Name, obj.URL, obj.Description, obj.Kind, obj.Branch, obj.Username, obj.Password, obj.Annotations, obj.Labels)
+	case nil:
 		return nil
 	}
 
-	d.SetId(in.ID)
-	d.Set("name", in.Name)
-	d.Set("url", in.URL)
-	d.Set("description", in.Description)
-	d.Set("kind", in.Kind)
-	d.Set("branch", in.Branch)
+	return fmt.Errorf("[ERROR] Flattening catalog: unsupported type %T", in)
+}
 
-	err := d.Set("annotations", toMapInterface(in.Annotations))
+func flattenCatalogFields(d *schema.ResourceData, name, url, description, kind, branch, username, password string, annotations, labels map[string]string) error {
+	d.Set("name", name)
+	d.Set("url", url)
+	d.Set("description", description)
+	d.Set("kind", kind)
+	d.Set("branch", branch)
+	d.Set("username", username)
+
+	// Rancher doesn't return the password, keeping the one on state
+	if len(password) > 0 {
+		d.Set("password", password)
+	}
+
+	err := d.Set("annotations", toMapInterface(annotations))
 	if err != nil {
 		return err
 	}
 
-	err = d.Set("labels", toMapInterface(in.Labels))
+	err = d.Set("labels", toMapInterface(labels))
 	if err != nil {
 		return err
 	}
 
 	return nil
-
 }
 
 // Expanders
 
-func expandCatalog(in *schema.ResourceData) *managementClient.Catalog {
+// expandCatalog returns a *managementClient.Catalog, *managementClient.ClusterCatalog or *managementClient.ProjectCatalog depending on scope
+func expandCatalog(in *schema.ResourceData) interface{} {
+	if in == nil {
+		return nil
+	}
+
+	switch in.Get("scope").(string) {
+	case catalogScopeCluster:
+		return expandClusterCatalog(in)
+	case catalogScopeProject:
+		return expandProjectCatalog(in)
+	}
+
+	return expandGlobalCatalog(in)
+}
+
+func expandGlobalCatalog(in *schema.ResourceData) *managementClient.Catalog {
 	obj := &managementClient.Catalog{}
 	if in == nil {
 		return nil
@@ -50,6 +100,68 @@ func expandCatalog(in *schema.ResourceData) *managementClient.Catalog {
 	obj.Description = in.Get("description").(string)
 	obj.Kind = in.Get("kind").(string)
 	obj.Branch = in.Get("branch").(string)
+	obj.Username = in.Get("username").(string)
+	obj.Password = in.Get("password").(string)
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
+
+func expandClusterCatalog(in *schema.ResourceData) *managementClient.ClusterCatalog {
+	obj := &managementClient.ClusterCatalog{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.Name = in.Get("name").(string)
+	obj.ClusterID = in.Get("cluster_id").(string)
+	obj.URL = in.Get("url").(string)
+	obj.Description = in.Get("description").(string)
+	obj.Kind = in.Get("kind").(string)
+	obj.Branch = in.Get("branch").(string)
+	obj.Username = in.Get("username").(string)
+	obj.Password = in.Get("password").(string)
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
+
+func expandProjectCatalog(in *schema.ResourceData) *managementClient.ProjectCatalog {
+	obj := &managementClient.ProjectCatalog{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.Name = in.Get("name").(string)
+	obj.ProjectID = in.Get("project_id").(string)
+	obj.URL = in.Get("url").(string)
+	obj.Description = in.Get("description").(string)
+	obj.Kind = in.Get("kind").(string)
+	obj.Branch = in.Get("branch").(string)
+	obj.Username = in.Get("username").(string)
+	obj.Password = in.Get("password").(string)
 
 	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
 		obj.Annotations = toMapString(v)
@@ -0,0 +1,46 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenCatalogTemplate(d *schema.ResourceData, in *managementClient.CatalogTemplate) error {
+	if in == nil {
+		return nil
+	}
+
+	// Name is kept from config, in.Name is prefixed by the catalog name
+	d.SetId(in.ID)
+	d.Set("default_template_version_id", in.DefaultTemplateVersionID)
+	d.Set("default_version", in.DefaultVersion)
+	d.Set("description", in.Description)
+
+	err := d.Set("categories", toArrayInterface(in.Categories))
+	if err != nil {
+		return err
+	}
+
+	versions := make([]interface{}, len(in.Versions))
+	for i, version := range in.Versions {
+		versions[i] = version.Version
+	}
+	err = d.Set("versions", versions)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
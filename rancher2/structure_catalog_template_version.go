@@ -0,0 +1,87 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+// flattenCatalogTemplateVersionQuestions returns questions and their subquestions on the same list
+func flattenCatalogTemplateVersionQuestions(in []managementClient.Question) []interface{} {
+	out := []interface{}{}
+
+	for _, q := range in {
+		out = append(out, map[string]interface{}{
+			"default":     q.Default,
+			"description": q.Description,
+			"group":       q.Group,
+			"label":       q.Label,
+			"options":     toArrayInterface(q.Options),
+			"required":    q.Required,
+			"show_if":     q.ShowIf,
+			"type":        q.Type,
+			"variable":    q.Variable,
+		})
+
+		for _, sq := range q.Subquestions {
+			out = append(out, map[string]interface{}{
+				"default":     sq.Default,
+				"description": sq.Description,
+				"group":       sq.Group,
+				"label":       sq.Label,
+				"options":     toArrayInterface(sq.Options),
+				"required":    sq.Required,
+				"show_if":     sq.ShowIf,
+				"type":        sq.Type,
+				"variable":    sq.Variable,
+			})
+		}
+	}
+
+	return out
+}
+
+// flattenCatalogTemplateVersionDefaultAnswers returns questions and subquestions default values by variable
+func flattenCatalogTemplateVersionDefaultAnswers(in []managementClient.Question) map[string]interface{} {
+	out := map[string]interface{}{}
+
+	for _, q := range in {
+		if len(q.Default) > 0 {
+			out[q.Variable] = q.Default
+		}
+
+		for _, sq := range q.Subquestions {
+			if len(sq.Default) > 0 {
+				out[sq.Variable] = sq.Default
+			}
+		}
+	}
+
+	return out
+}
+
+func flattenCatalogTemplateVersion(d *schema.ResourceData, in *managementClient.TemplateVersion) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("version", in.Version)
+	d.Set("external_id", in.ExternalID)
+	d.Set("kube_version", in.KubeVersion)
+	d.Set("rancher_version", in.RancherVersion)
+	d.Set("required_namespace", in.RequiredNamespace)
+
+	err := d.Set("questions", flattenCatalogTemplateVersionQuestions(in.Questions))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("default_answers", flattenCatalogTemplateVersionDefaultAnswers(in.Questions))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
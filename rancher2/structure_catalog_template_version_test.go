@@ -0,0 +1,124 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testCatalogTemplateVersionQuestionsConf           []managementClient.Question
+	testCatalogTemplateVersionQuestionsInterface      []interface{}
+	testCatalogTemplateVersionDefaultAnswersInterface map[string]interface{}
+)
+
+func init() {
+	testCatalogTemplateVersionQuestionsConf = []managementClient.Question{
+		{
+			Variable:    "ingress_host",
+			Default:     "xip.io",
+			Description: "Ingress host",
+			Group:       "Services and Load Balancing",
+			Label:       "Hostname",
+			Required:    true,
+			Type:        "hostname",
+			Subquestions: []managementClient.SubQuestion{
+				{
+					Variable: "ingress_path",
+					Default:  "/",
+					Label:    "Path",
+					ShowIf:   "ingress=true",
+					Type:     "string",
+				},
+			},
+		},
+		{
+			Variable: "persistence",
+			Label:    "Persistence",
+			Options:  []string{"true", "false"},
+			Type:     "enum",
+		},
+	}
+	testCatalogTemplateVersionQuestionsInterface = []interface{}{
+		map[string]interface{}{
+			"default":     "xip.io",
+			"description": "Ingress host",
+			"group":       "Services and Load Balancing",
+			"label":       "Hostname",
+			"options":     []interface{}{},
+			"required":    true,
+			"show_if":     "",
+			"type":        "hostname",
+			"variable":    "ingress_host",
+		},
+		map[string]interface{}{
+			"default":     "/",
+			"description": "",
+			"group":       "",
+			"label":       "Path",
+			"options":     []interface{}{},
+			"required":    false,
+			"show_if":     "ingress=true",
+			"type":        "string",
+			"variable":    "ingress_path",
+		},
+		map[string]interface{}{
+			"default":     "",
+			"description": "",
+			"group":       "",
+			"label":       "Persistence",
+			"options":     []interface{}{"true", "false"},
+			"required":    false,
+			"show_if":     "",
+			"type":        "enum",
+			"variable":    "persistence",
+		},
+	}
+	testCatalogTemplateVersionDefaultAnswersInterface = map[string]interface{}{
+		"ingress_host": "xip.io",
+		"ingress_path": "/",
+	}
+}
+
+func TestFlattenCatalogTemplateVersionQuestions(t *testing.T) {
+
+	cases := []struct {
+		Input          []managementClient.Question
+		ExpectedOutput []interface{}
+	}{
+		{
+			testCatalogTemplateVersionQuestionsConf,
+			testCatalogTemplateVersionQuestionsInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenCatalogTemplateVersionQuestions(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestFlattenCatalogTemplateVersionDefaultAnswers(t *testing.T) {
+
+	cases := []struct {
+		Input          []managementClient.Question
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testCatalogTemplateVersionQuestionsConf,
+			testCatalogTemplateVersionDefaultAnswersInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenCatalogTemplateVersionDefaultAnswers(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
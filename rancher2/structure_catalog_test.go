@@ -9,8 +9,12 @@ import (
 )
 
 var (
-	testCatalogConf      *managementClient.Catalog
-	testCatalogInterface map[string]interface{}
+	testCatalogConf             *managementClient.Catalog
+	testCatalogInterface        map[string]interface{}
+	testCatalogClusterConf      *managementClient.ClusterCatalog
+	testCatalogClusterInterface map[string]interface{}
+	testCatalogProjectConf      *managementClient.ProjectCatalog
+	testCatalogProjectInterface map[string]interface{}
 )
 
 func init() {
@@ -20,6 +24,8 @@ func init() {
 		Description: "description",
 		Kind:        "kind",
 		Branch:      "branch",
+		Username:    "username",
+		Password:    "password",
 	}
 	testCatalogInterface = map[string]interface{}{
 		"name":        "catalog-test",
@@ -27,19 +33,72 @@ func init() {
 		"description": "description",
 		"kind":        "kind",
 		"branch":      "branch",
+		"username":    "username",
+		"password":    "password",
+		"scope":       "global",
+	}
+	testCatalogClusterConf = &managementClient.ClusterCatalog{
+		Name:        "catalog-test",
+		ClusterID:   "c-test",
+		URL:         "url",
+		Description: "description",
+		Kind:        "kind",
+		Branch:      "branch",
+		Username:    "username",
+		Password:    "password",
+	}
+	testCatalogClusterInterface = map[string]interface{}{
+		"name":        "catalog-test",
+		"cluster_id":  "c-test",
+		"url":         "url",
+		"description": "description",
+		"kind":        "kind",
+		"branch":      "branch",
+		"username":    "username",
+		"password":    "password",
+		"scope":       "cluster",
+	}
+	testCatalogProjectConf = &managementClient.ProjectCatalog{
+		Name:        "catalog-test",
+		ProjectID:   "c-test:p-test",
+		URL:         "url",
+		Description: "description",
+		Kind:        "kind",
+		Branch:      "branch",
+		Username:    "username",
+		Password:    "password",
+	}
+	testCatalogProjectInterface = map[string]interface{}{
+		"name":        "catalog-test",
+		"project_id":  "c-test:p-test",
+		"url":         "url",
+		"description": "description",
+		"kind":        "kind",
+		"branch":      "branch",
+		"username":    "username",
+		"password":    "password",
+		"scope":       "project",
 	}
 }
 
 func TestFlattenCatalog(t *testing.T) {
 
 	cases := []struct {
-		Input          *managementClient.Catalog
+		Input          interface{}
 		ExpectedOutput map[string]interface{}
 	}{
 		{
 			testCatalogConf,
 			testCatalogInterface,
 		},
+		{
+			testCatalogClusterConf,
+			testCatalogClusterInterface,
+		},
+		{
+			testCatalogProjectConf,
+			testCatalogProjectInterface,
+		},
 	}
 
 	for _, tc := range cases {
@@ -63,12 +122,20 @@ func TestExpandCatalog(t *testing.T) {
 
 	cases := []struct {
 		Input          map[string]interface{}
-		ExpectedOutput *managementClient.Catalog
+		ExpectedOutput interface{}
 	}{
 		{
 			testCatalogInterface,
 			testCatalogConf,
 		},
+		{
+			testCatalogClusterInterface,
+			testCatalogClusterConf,
+		},
+		{
+			testCatalogProjectInterface,
+			testCatalogProjectConf,
+		},
 	}
 
 	for _, tc := range cases {
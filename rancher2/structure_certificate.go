@@ -0,0 +1,88 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+// Flatteners
+
+func flattenCertificate(d *schema.ResourceData, in *projectClient.Certificate) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("name", in.Name)
+	d.Set("description", in.Description)
+
+	if len(in.ProjectID) > 0 {
+		d.Set("project_id", in.ProjectID)
+	}
+
+	if len(in.NamespaceId) > 0 {
+		d.Set("namespace_id", in.NamespaceId)
+	}
+
+	// Certs are only updated on state if its checksum has changed since last apply, forcing a diff.
+	// Key is not returned by rancher
+	if checksum := GetSHA256Checksum(in.Certs); checksum != d.Get("certs_checksum").(string) {
+		d.Set("certs", Base64Encode(in.Certs))
+		d.Set("certs_checksum", checksum)
+	}
+
+	err := d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Expanders
+
+func expandCertificate(in *schema.ResourceData) (*projectClient.Certificate, error) {
+	obj := &projectClient.Certificate{}
+	if in == nil {
+		return nil, nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.ProjectID = in.Get("project_id").(string)
+	obj.Name = in.Get("name").(string)
+	obj.Description = in.Get("description").(string)
+
+	if v, ok := in.Get("namespace_id").(string); ok && len(v) > 0 {
+		obj.NamespaceId = v
+	}
+
+	certs, err := Base64Decode(in.Get("certs").(string))
+	if err != nil {
+		return nil, err
+	}
+	obj.Certs = certs
+
+	key, err := Base64Decode(in.Get("key").(string))
+	if err != nil {
+		return nil, err
+	}
+	obj.Key = key
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,111 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+var (
+	testCertificateConf                *projectClient.Certificate
+	testCertificateInterface           map[string]interface{}
+	testCertificateNamespacedConf      *projectClient.Certificate
+	testCertificateNamespacedInterface map[string]interface{}
+)
+
+func init() {
+	testCertificateConf = &projectClient.Certificate{
+		ProjectID:   "project:test",
+		Name:        "test",
+		Description: "description",
+		Certs:       "certs",
+		Key:         "key",
+	}
+	testCertificateInterface = map[string]interface{}{
+		"project_id":  "project:test",
+		"name":        "test",
+		"description": "description",
+		"certs":       "Y2VydHM=",
+		"key":         "a2V5",
+	}
+	testCertificateNamespacedConf = &projectClient.Certificate{
+		ProjectID:   "project:test",
+		NamespaceId: "namespace_test",
+		Name:        "test",
+		Description: "description",
+		Certs:       "certs",
+		Key:         "key",
+	}
+	testCertificateNamespacedInterface = map[string]interface{}{
+		"project_id":   "project:test",
+		"namespace_id": "namespace_test",
+		"name":         "test",
+		"description":  "description",
+		"certs":        "Y2VydHM=",
+		"key":          "a2V5",
+	}
+}
+
+func TestFlattenCertificate(t *testing.T) {
+
+	cases := []struct {
+		Input          *projectClient.Certificate
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testCertificateConf,
+			testCertificateInterface,
+		},
+		{
+			testCertificateNamespacedConf,
+			testCertificateNamespacedInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, certificateFields(), tc.ExpectedOutput)
+		err := flattenCertificate(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandCertificate(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *projectClient.Certificate
+	}{
+		{
+			testCertificateInterface,
+			testCertificateConf,
+		},
+		{
+			testCertificateNamespacedInterface,
+			testCertificateNamespacedConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, certificateFields(), tc.Input)
+		output, err := expandCertificate(inputResourceData)
+		if err != nil {
+			t.Fatalf("[ERROR] on expander: %#v", err)
+		}
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
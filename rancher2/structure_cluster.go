@@ -48,6 +48,8 @@ func flattenCluster(d *schema.ResourceData, in *Cluster, clusterRegToken *manage
 
 	d.Set("name", in.Name)
 	d.Set("description", in.Description)
+	d.Set("default_pod_security_policy_template_id", in.DefaultPodSecurityPolicyTemplateID)
+	d.Set("enable_cluster_monitoring", in.EnableClusterMonitoring)
 
 	err := d.Set("annotations", toMapInterface(in.Annotations))
 	if err != nil {
@@ -158,6 +160,7 @@ func expandCluster(in *schema.ResourceData) (*Cluster, error) {
 
 	obj.Name = in.Get("name").(string)
 	obj.Description = in.Get("description").(string)
+	obj.DefaultPodSecurityPolicyTemplateID = in.Get("default_pod_security_policy_template_id").(string)
 
 	if v, ok := in.Get("aks_config").([]interface{}); ok && len(v) > 0 {
 		aksConfig, err := expandClusterAKSConfig(v, obj.Name)
@@ -0,0 +1,98 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testClusterAlertGroupConf      *managementClient.ClusterAlertGroup
+	testClusterAlertGroupInterface map[string]interface{}
+)
+
+func init() {
+	testClusterAlertGroupConf = &managementClient.ClusterAlertGroup{
+		ClusterID:             "c-test",
+		Name:                  "test",
+		Description:           "description",
+		GroupIntervalSeconds:  300,
+		GroupWaitSeconds:      60,
+		RepeatIntervalSeconds: 7200,
+		Recipients: []managementClient.Recipient{
+			{
+				NotifierID:   "c-test:n-slack",
+				NotifierType: alertRecipientTypeSlack,
+				Recipient:    "#foo",
+			},
+		},
+	}
+	testClusterAlertGroupInterface = map[string]interface{}{
+		"cluster_id":              "c-test",
+		"name":                    "test",
+		"description":             "description",
+		"group_interval_seconds":  300,
+		"group_wait_seconds":      60,
+		"repeat_interval_seconds": 7200,
+		"recipients": []interface{}{
+			map[string]interface{}{
+				"notifier_id":   "c-test:n-slack",
+				"notifier_type": alertRecipientTypeSlack,
+				"recipient":     "#foo",
+			},
+		},
+	}
+}
+
+func TestFlattenClusterAlertGroup(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.ClusterAlertGroup
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testClusterAlertGroupConf,
+			testClusterAlertGroupInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, clusterAlertGroupFields(), map[string]interface{}{})
+		err := flattenClusterAlertGroup(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandClusterAlertGroup(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *managementClient.ClusterAlertGroup
+	}{
+		{
+			testClusterAlertGroupInterface,
+			testClusterAlertGroupConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, clusterAlertGroupFields(), tc.Input)
+		output := expandClusterAlertGroup(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
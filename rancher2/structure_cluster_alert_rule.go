@@ -0,0 +1,129 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenClusterAlertRule(d *schema.ResourceData, in *managementClient.ClusterAlertRule) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("cluster_id", in.ClusterID)
+	d.Set("group_id", in.GroupID)
+	d.Set("name", in.Name)
+	d.Set("group_interval_seconds", int(in.GroupIntervalSeconds))
+	d.Set("group_wait_seconds", int(in.GroupWaitSeconds))
+	d.Set("repeat_interval_seconds", int(in.RepeatIntervalSeconds))
+	d.Set("severity", in.Severity)
+
+	if in.Inherited != nil {
+		d.Set("inherited", *in.Inherited)
+	}
+
+	// Inactive rules can't be muted, keeping muted argument on state
+	switch in.AlertState {
+	case alertRuleStateInactive:
+		d.Set("enabled", false)
+	case alertRuleStateMuted:
+		d.Set("enabled", true)
+		d.Set("muted", true)
+	default:
+		d.Set("enabled", true)
+		d.Set("muted", false)
+	}
+
+	if in.EventRule != nil {
+		err := d.Set("event_rule", flattenAlertEventRule(in.EventRule))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.MetricRule != nil {
+		err := d.Set("metric_rule", flattenAlertMetricRule(in.MetricRule))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.NodeRule != nil {
+		err := d.Set("node_rule", flattenAlertNodeRule(in.NodeRule))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.SystemServiceRule != nil {
+		err := d.Set("system_service_rule", flattenAlertSystemServiceRule(in.SystemServiceRule))
+		if err != nil {
+			return err
+		}
+	}
+
+	err := d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Expanders
+
+func expandClusterAlertRule(in *schema.ResourceData) *managementClient.ClusterAlertRule {
+	obj := &managementClient.ClusterAlertRule{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.ClusterID = in.Get("cluster_id").(string)
+	obj.GroupID = in.Get("group_id").(string)
+	obj.Name = in.Get("name").(string)
+	obj.GroupIntervalSeconds = int64(in.Get("group_interval_seconds").(int))
+	obj.GroupWaitSeconds = int64(in.Get("group_wait_seconds").(int))
+	obj.RepeatIntervalSeconds = int64(in.Get("repeat_interval_seconds").(int))
+	obj.Severity = in.Get("severity").(string)
+
+	inherited := in.Get("inherited").(bool)
+	obj.Inherited = &inherited
+
+	if v, ok := in.Get("event_rule").([]interface{}); ok && len(v) > 0 {
+		obj.EventRule = expandAlertEventRule(v)
+	}
+
+	if v, ok := in.Get("metric_rule").([]interface{}); ok && len(v) > 0 {
+		obj.MetricRule = expandAlertMetricRule(v)
+	}
+
+	if v, ok := in.Get("node_rule").([]interface{}); ok && len(v) > 0 {
+		obj.NodeRule = expandAlertNodeRule(v)
+	}
+
+	if v, ok := in.Get("system_service_rule").([]interface{}); ok && len(v) > 0 {
+		obj.SystemServiceRule = expandAlertSystemServiceRule(v)
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
@@ -0,0 +1,98 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testClusterAlertRuleConf      *managementClient.ClusterAlertRule
+	testClusterAlertRuleInterface map[string]interface{}
+)
+
+func init() {
+	testClusterAlertRuleConf = &managementClient.ClusterAlertRule{
+		ClusterID:             "c-test",
+		GroupID:               "c-test:g-test",
+		Name:                  "test",
+		GroupIntervalSeconds:  300,
+		GroupWaitSeconds:      60,
+		Inherited:             newFalse(),
+		RepeatIntervalSeconds: 7200,
+		Severity:              alertRuleSeverityWarning,
+		SystemServiceRule: &managementClient.SystemServiceRule{
+			Condition: "etcd",
+		},
+	}
+	testClusterAlertRuleInterface = map[string]interface{}{
+		"cluster_id":              "c-test",
+		"group_id":                "c-test:g-test",
+		"name":                    "test",
+		"enabled":                 true,
+		"group_interval_seconds":  300,
+		"group_wait_seconds":      60,
+		"inherited":               false,
+		"muted":                   false,
+		"repeat_interval_seconds": 7200,
+		"severity":                alertRuleSeverityWarning,
+		"system_service_rule": []interface{}{
+			map[string]interface{}{
+				"condition": "etcd",
+			},
+		},
+	}
+}
+
+func TestFlattenClusterAlertRule(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.ClusterAlertRule
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testClusterAlertRuleConf,
+			testClusterAlertRuleInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, clusterAlertRuleFields(), map[string]interface{}{})
+		err := flattenClusterAlertRule(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandClusterAlertRule(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *managementClient.ClusterAlertRule
+	}{
+		{
+			testClusterAlertRuleInterface,
+			testClusterAlertRuleConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, clusterAlertRuleFields(), tc.Input)
+		output := expandClusterAlertRule(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testClusterMonitoringInputConf      *MonitoringInput
+	testClusterMonitoringInputInterface []interface{}
+)
+
+func init() {
+	testClusterMonitoringInputConf = &MonitoringInput{
+		MonitoringInput: managementClient.MonitoringInput{
+			Answers: map[string]string{
+				"prometheus.retention": "12h",
+			},
+		},
+		Version: "0.0.3",
+	}
+	testClusterMonitoringInputInterface = []interface{}{
+		map[string]interface{}{
+			"answers": map[string]interface{}{
+				"prometheus.retention": "12h",
+			},
+			"version": "0.0.3",
+		},
+	}
+}
+
+func TestFlattenClusterMonitoringInput(t *testing.T) {
+
+	cases := []struct {
+		Input          *MonitoringInput
+		ExpectedOutput []interface{}
+	}{
+		{
+			testClusterMonitoringInputConf,
+			testClusterMonitoringInputInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenClusterMonitoringInput(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandClusterMonitoringInput(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *MonitoringInput
+	}{
+		{
+			testClusterMonitoringInputInterface,
+			testClusterMonitoringInputConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandClusterMonitoringInput(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -124,16 +124,18 @@ func init() {
 	testClusterConfRKE = &Cluster{}
 	testClusterConfRKE.Name = "test"
 	testClusterConfRKE.Description = "description"
+	testClusterConfRKE.DefaultPodSecurityPolicyTemplateID = "restricted"
 	testClusterConfRKE.RancherKubernetesEngineConfig = testClusterRKEConfigConf
 	testClusterConfRKE.Driver = clusterDriverRKE
 	testClusterInterfaceRKE = map[string]interface{}{
-		"id":                         "id",
-		"name":                       "test",
-		"description":                "description",
-		"cluster_registration_token": testClusterRegistrationTokenInterface,
-		"kube_config":                "kube_config",
-		"driver":                     clusterDriverRKE,
-		"rke_config":                 testClusterRKEConfigInterface,
+		"id":          "id",
+		"name":        "test",
+		"description": "description",
+		"default_pod_security_policy_template_id": "restricted",
+		"cluster_registration_token":              testClusterRegistrationTokenInterface,
+		"kube_config":                             "kube_config",
+		"driver":                                  clusterDriverRKE,
+		"rke_config":                              testClusterRKEConfigInterface,
 	}
 }
 
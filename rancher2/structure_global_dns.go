@@ -0,0 +1,98 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenGlobalDNS(d *schema.ResourceData, in *managementClient.GlobalDNS) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("name", in.Name)
+	d.Set("fqdn", in.FQDN)
+	d.Set("provider_id", in.ProviderID)
+	d.Set("multi_cluster_app_id", in.MultiClusterAppID)
+	d.Set("ttl", int(in.TTL))
+
+	err := d.Set("project_ids", toArrayInterface(in.ProjectIDs))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Expanders
+
+func expandGlobalDNS(in *schema.ResourceData) *managementClient.GlobalDNS {
+	obj := &managementClient.GlobalDNS{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.Name = in.Get("name").(string)
+	obj.FQDN = in.Get("fqdn").(string)
+	obj.ProviderID = in.Get("provider_id").(string)
+	obj.MultiClusterAppID = in.Get("multi_cluster_app_id").(string)
+	obj.TTL = int64(in.Get("ttl").(int))
+
+	if v, ok := in.Get("project_ids").([]interface{}); ok && len(v) > 0 {
+		obj.ProjectIDs = toArrayString(v)
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
+
+// expandGlobalDNSProjectsUpdate returns the inputs to add and remove projects from old to new project ids
+func expandGlobalDNSProjectsUpdate(old, new []interface{}) (*managementClient.UpdateGlobalDNSTargetsInput, *managementClient.UpdateGlobalDNSTargetsInput) {
+	add := &managementClient.UpdateGlobalDNSTargetsInput{}
+	remove := &managementClient.UpdateGlobalDNSTargetsInput{}
+
+	oldIDs := map[string]bool{}
+	for _, v := range old {
+		oldIDs[v.(string)] = true
+	}
+
+	newIDs := map[string]bool{}
+	for _, v := range new {
+		newIDs[v.(string)] = true
+		if !oldIDs[v.(string)] {
+			add.ProjectIDs = append(add.ProjectIDs, v.(string))
+		}
+	}
+
+	for _, v := range old {
+		if !newIDs[v.(string)] {
+			remove.ProjectIDs = append(remove.ProjectIDs, v.(string))
+		}
+	}
+
+	return add, remove
+}
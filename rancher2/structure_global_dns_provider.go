@@ -0,0 +1,101 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenGlobalDNSProvider(d *schema.ResourceData, in *managementClient.GlobalDNSProvider) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("name", in.Name)
+	d.Set("root_domain", in.RootDomain)
+
+	if in.AlidnsProviderConfig != nil {
+		v, ok := d.Get("alidns_config").([]interface{})
+		if !ok {
+			v = []interface{}{}
+		}
+		err := d.Set("alidns_config", flattenGlobalDNSProviderAlidnsConfig(in.AlidnsProviderConfig, v))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.CloudflareProviderConfig != nil {
+		v, ok := d.Get("cloudflare_config").([]interface{})
+		if !ok {
+			v = []interface{}{}
+		}
+		err := d.Set("cloudflare_config", flattenGlobalDNSProviderCloudflareConfig(in.CloudflareProviderConfig, v))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.Route53ProviderConfig != nil {
+		v, ok := d.Get("route53_config").([]interface{})
+		if !ok {
+			v = []interface{}{}
+		}
+		err := d.Set("route53_config", flattenGlobalDNSProviderRoute53Config(in.Route53ProviderConfig, v))
+		if err != nil {
+			return err
+		}
+	}
+
+	err := d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Expanders
+
+func expandGlobalDNSProvider(in *schema.ResourceData) *managementClient.GlobalDNSProvider {
+	obj := &managementClient.GlobalDNSProvider{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.Name = in.Get("name").(string)
+	obj.RootDomain = in.Get("root_domain").(string)
+
+	if v, ok := in.Get("alidns_config").([]interface{}); ok && len(v) > 0 {
+		obj.AlidnsProviderConfig = expandGlobalDNSProviderAlidnsConfig(v)
+	}
+
+	if v, ok := in.Get("cloudflare_config").([]interface{}); ok && len(v) > 0 {
+		obj.CloudflareProviderConfig = expandGlobalDNSProviderCloudflareConfig(v)
+	}
+
+	if v, ok := in.Get("route53_config").([]interface{}); ok && len(v) > 0 {
+		obj.Route53ProviderConfig = expandGlobalDNSProviderRoute53Config(v)
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
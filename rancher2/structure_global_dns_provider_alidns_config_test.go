@@ -0,0 +1,68 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testGlobalDNSProviderAlidnsConfigConf      *managementClient.AlidnsProviderConfig
+	testGlobalDNSProviderAlidnsConfigInterface []interface{}
+)
+
+func init() {
+	testGlobalDNSProviderAlidnsConfigConf = &managementClient.AlidnsProviderConfig{
+		AccessKey: "access_key",
+		SecretKey: "secret_key",
+	}
+	testGlobalDNSProviderAlidnsConfigInterface = []interface{}{
+		map[string]interface{}{
+			"access_key": "access_key",
+			"secret_key": "secret_key",
+		},
+	}
+}
+
+func TestFlattenGlobalDNSProviderAlidnsConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.AlidnsProviderConfig
+		ExpectedOutput []interface{}
+	}{
+		{
+			testGlobalDNSProviderAlidnsConfigConf,
+			testGlobalDNSProviderAlidnsConfigInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenGlobalDNSProviderAlidnsConfig(tc.Input, []interface{}{})
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandGlobalDNSProviderAlidnsConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.AlidnsProviderConfig
+	}{
+		{
+			testGlobalDNSProviderAlidnsConfigInterface,
+			testGlobalDNSProviderAlidnsConfigConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandGlobalDNSProviderAlidnsConfig(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package rancher2
+
+import (
+	"strconv"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenGlobalDNSProviderCloudflareConfig(in *managementClient.CloudflareProviderConfig, p []interface{}) []interface{} {
+	var obj map[string]interface{}
+	if len(p) == 0 || p[0] == nil {
+		obj = make(map[string]interface{})
+	} else {
+		obj = p[0].(map[string]interface{})
+	}
+
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if len(in.APIEmail) > 0 {
+		obj["api_email"] = in.APIEmail
+	}
+
+	// Rancher doesn't return the api key, keeping the one on state
+	if len(in.APIKey) > 0 {
+		obj["api_key"] = in.APIKey
+	}
+
+	if v, err := strconv.ParseBool(in.ProxySetting); err == nil {
+		obj["proxy_setting"] = v
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandGlobalDNSProviderCloudflareConfig(p []interface{}) *managementClient.CloudflareProviderConfig {
+	obj := &managementClient.CloudflareProviderConfig{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["api_email"].(string); ok && len(v) > 0 {
+		obj.APIEmail = v
+	}
+
+	if v, ok := in["api_key"].(string); ok && len(v) > 0 {
+		obj.APIKey = v
+	}
+
+	if v, ok := in["proxy_setting"].(bool); ok {
+		obj.ProxySetting = strconv.FormatBool(v)
+	}
+
+	return obj
+}
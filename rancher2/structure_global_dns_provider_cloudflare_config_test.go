@@ -0,0 +1,70 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testGlobalDNSProviderCloudflareConfigConf      *managementClient.CloudflareProviderConfig
+	testGlobalDNSProviderCloudflareConfigInterface []interface{}
+)
+
+func init() {
+	testGlobalDNSProviderCloudflareConfigConf = &managementClient.CloudflareProviderConfig{
+		APIEmail:     "test@test.local",
+		APIKey:       "api_key",
+		ProxySetting: "false",
+	}
+	testGlobalDNSProviderCloudflareConfigInterface = []interface{}{
+		map[string]interface{}{
+			"api_email":     "test@test.local",
+			"api_key":       "api_key",
+			"proxy_setting": false,
+		},
+	}
+}
+
+func TestFlattenGlobalDNSProviderCloudflareConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.CloudflareProviderConfig
+		ExpectedOutput []interface{}
+	}{
+		{
+			testGlobalDNSProviderCloudflareConfigConf,
+			testGlobalDNSProviderCloudflareConfigInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenGlobalDNSProviderCloudflareConfig(tc.Input, []interface{}{})
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandGlobalDNSProviderCloudflareConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.CloudflareProviderConfig
+	}{
+		{
+			testGlobalDNSProviderCloudflareConfigInterface,
+			testGlobalDNSProviderCloudflareConfigConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandGlobalDNSProviderCloudflareConfig(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
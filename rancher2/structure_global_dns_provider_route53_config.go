@@ -0,0 +1,83 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenGlobalDNSProviderRoute53Config(in *managementClient.Route53ProviderConfig, p []interface{}) []interface{} {
+	var obj map[string]interface{}
+	if len(p) == 0 || p[0] == nil {
+		obj = make(map[string]interface{})
+	} else {
+		obj = p[0].(map[string]interface{})
+	}
+
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if len(in.AccessKey) > 0 {
+		obj["access_key"] = in.AccessKey
+	}
+
+	// Rancher doesn't return the secret key, keeping the one on state
+	if len(in.SecretKey) > 0 {
+		obj["secret_key"] = in.SecretKey
+	}
+
+	if len(in.CredentialsPath) > 0 {
+		obj["credentials_path"] = in.CredentialsPath
+	}
+
+	if len(in.Region) > 0 {
+		obj["region"] = in.Region
+	}
+
+	if len(in.RoleArn) > 0 {
+		obj["role_arn"] = in.RoleArn
+	}
+
+	if len(in.ZoneType) > 0 {
+		obj["zone_type"] = in.ZoneType
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandGlobalDNSProviderRoute53Config(p []interface{}) *managementClient.Route53ProviderConfig {
+	obj := &managementClient.Route53ProviderConfig{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["access_key"].(string); ok && len(v) > 0 {
+		obj.AccessKey = v
+	}
+
+	if v, ok := in["secret_key"].(string); ok && len(v) > 0 {
+		obj.SecretKey = v
+	}
+
+	if v, ok := in["credentials_path"].(string); ok && len(v) > 0 {
+		obj.CredentialsPath = v
+	}
+
+	if v, ok := in["region"].(string); ok && len(v) > 0 {
+		obj.Region = v
+	}
+
+	if v, ok := in["role_arn"].(string); ok && len(v) > 0 {
+		obj.RoleArn = v
+	}
+
+	if v, ok := in["zone_type"].(string); ok && len(v) > 0 {
+		obj.ZoneType = v
+	}
+
+	return obj
+}
@@ -0,0 +1,76 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testGlobalDNSProviderRoute53ConfigConf      *managementClient.Route53ProviderConfig
+	testGlobalDNSProviderRoute53ConfigInterface []interface{}
+)
+
+func init() {
+	testGlobalDNSProviderRoute53ConfigConf = &managementClient.Route53ProviderConfig{
+		AccessKey:       "access_key",
+		SecretKey:       "secret_key",
+		CredentialsPath: "/.aws",
+		Region:          "us-west-2",
+		RoleArn:         "role_arn",
+		ZoneType:        "private",
+	}
+	testGlobalDNSProviderRoute53ConfigInterface = []interface{}{
+		map[string]interface{}{
+			"access_key":       "access_key",
+			"secret_key":       "secret_key",
+			"credentials_path": "/.aws",
+			"region":           "us-west-2",
+			"role_arn":         "role_arn",
+			"zone_type":        "private",
+		},
+	}
+}
+
+func TestFlattenGlobalDNSProviderRoute53Config(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.Route53ProviderConfig
+		ExpectedOutput []interface{}
+	}{
+		{
+			testGlobalDNSProviderRoute53ConfigConf,
+			testGlobalDNSProviderRoute53ConfigInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenGlobalDNSProviderRoute53Config(tc.Input, []interface{}{})
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandGlobalDNSProviderRoute53Config(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.Route53ProviderConfig
+	}{
+		{
+			testGlobalDNSProviderRoute53ConfigInterface,
+			testGlobalDNSProviderRoute53ConfigConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandGlobalDNSProviderRoute53Config(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
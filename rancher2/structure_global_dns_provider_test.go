@@ -0,0 +1,118 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testGlobalDNSProviderConfAlidns          *managementClient.GlobalDNSProvider
+	testGlobalDNSProviderInterfaceAlidns     map[string]interface{}
+	testGlobalDNSProviderConfCloudflare      *managementClient.GlobalDNSProvider
+	testGlobalDNSProviderInterfaceCloudflare map[string]interface{}
+	testGlobalDNSProviderConfRoute53         *managementClient.GlobalDNSProvider
+	testGlobalDNSProviderInterfaceRoute53    map[string]interface{}
+)
+
+func init() {
+	testGlobalDNSProviderConfAlidns = &managementClient.GlobalDNSProvider{
+		Name:                 "test",
+		RootDomain:           "example.com",
+		AlidnsProviderConfig: testGlobalDNSProviderAlidnsConfigConf,
+	}
+	testGlobalDNSProviderInterfaceAlidns = map[string]interface{}{
+		"name":          "test",
+		"root_domain":   "example.com",
+		"alidns_config": testGlobalDNSProviderAlidnsConfigInterface,
+	}
+	testGlobalDNSProviderConfCloudflare = &managementClient.GlobalDNSProvider{
+		Name:                     "test",
+		RootDomain:               "example.com",
+		CloudflareProviderConfig: testGlobalDNSProviderCloudflareConfigConf,
+	}
+	testGlobalDNSProviderInterfaceCloudflare = map[string]interface{}{
+		"name":              "test",
+		"root_domain":       "example.com",
+		"cloudflare_config": testGlobalDNSProviderCloudflareConfigInterface,
+	}
+	testGlobalDNSProviderConfRoute53 = &managementClient.GlobalDNSProvider{
+		Name:                  "test",
+		RootDomain:            "example.com",
+		Route53ProviderConfig: testGlobalDNSProviderRoute53ConfigConf,
+	}
+	testGlobalDNSProviderInterfaceRoute53 = map[string]interface{}{
+		"name":           "test",
+		"root_domain":    "example.com",
+		"route53_config": testGlobalDNSProviderRoute53ConfigInterface,
+	}
+}
+
+func TestFlattenGlobalDNSProvider(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.GlobalDNSProvider
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testGlobalDNSProviderConfAlidns,
+			testGlobalDNSProviderInterfaceAlidns,
+		},
+		{
+			testGlobalDNSProviderConfCloudflare,
+			testGlobalDNSProviderInterfaceCloudflare,
+		},
+		{
+			testGlobalDNSProviderConfRoute53,
+			testGlobalDNSProviderInterfaceRoute53,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, globalDNSProviderFields(), tc.ExpectedOutput)
+		err := flattenGlobalDNSProvider(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandGlobalDNSProvider(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *managementClient.GlobalDNSProvider
+	}{
+		{
+			testGlobalDNSProviderInterfaceAlidns,
+			testGlobalDNSProviderConfAlidns,
+		},
+		{
+			testGlobalDNSProviderInterfaceCloudflare,
+			testGlobalDNSProviderConfCloudflare,
+		},
+		{
+			testGlobalDNSProviderInterfaceRoute53,
+			testGlobalDNSProviderConfRoute53,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, globalDNSProviderFields(), tc.Input)
+		output := expandGlobalDNSProvider(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testGlobalDNSConf      *managementClient.GlobalDNS
+	testGlobalDNSInterface map[string]interface{}
+)
+
+func init() {
+	testGlobalDNSConf = &managementClient.GlobalDNS{
+		Name:       "test",
+		FQDN:       "test.example.com",
+		ProviderID: "cattle-global-data:test",
+		ProjectIDs: []string{"c-test:p-test", "c-test:p-test2"},
+		TTL:        600,
+	}
+	testGlobalDNSInterface = map[string]interface{}{
+		"name":                 "test",
+		"fqdn":                 "test.example.com",
+		"provider_id":          "cattle-global-data:test",
+		"multi_cluster_app_id": "",
+		"project_ids":          []interface{}{"c-test:p-test", "c-test:p-test2"},
+		"ttl":                  600,
+	}
+}
+
+func TestFlattenGlobalDNS(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.GlobalDNS
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testGlobalDNSConf,
+			testGlobalDNSInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, globalDNSFields(), map[string]interface{}{})
+		err := flattenGlobalDNS(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandGlobalDNS(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *managementClient.GlobalDNS
+	}{
+		{
+			testGlobalDNSInterface,
+			testGlobalDNSConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, globalDNSFields(), tc.Input)
+		output := expandGlobalDNS(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandGlobalDNSProjectsUpdate(t *testing.T) {
+	projects := testGlobalDNSInterface["project_ids"].([]interface{})
+
+	cases := []struct {
+		Old            []interface{}
+		New            []interface{}
+		ExpectedAdd    *managementClient.UpdateGlobalDNSTargetsInput
+		ExpectedRemove *managementClient.UpdateGlobalDNSTargetsInput
+	}{
+		{
+			projects[:1],
+			projects,
+			&managementClient.UpdateGlobalDNSTargetsInput{
+				ProjectIDs: []string{"c-test:p-test2"},
+			},
+			&managementClient.UpdateGlobalDNSTargetsInput{},
+		},
+		{
+			projects,
+			projects[1:],
+			&managementClient.UpdateGlobalDNSTargetsInput{},
+			&managementClient.UpdateGlobalDNSTargetsInput{
+				ProjectIDs: []string{"c-test:p-test"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		add, remove := expandGlobalDNSProjectsUpdate(tc.Old, tc.New)
+		if !reflect.DeepEqual(add, tc.ExpectedAdd) {
+			t.Fatalf("Unexpected add output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedAdd, add)
+		}
+		if !reflect.DeepEqual(remove, tc.ExpectedRemove) {
+			t.Fatalf("Unexpected remove output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedRemove, remove)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenGlobalRole(d *schema.ResourceData, in *managementClient.GlobalRole) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("name", in.Name)
+	d.Set("description", in.Description)
+	d.Set("new_user_default", in.NewUserDefault)
+
+	err := d.Set("rules", flattenPolicyRules(in.Rules))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// Expanders
+
+func expandGlobalRole(in *schema.ResourceData) *managementClient.GlobalRole {
+	obj := &managementClient.GlobalRole{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.Name = in.Get("name").(string)
+	obj.Description = in.Get("description").(string)
+	obj.NewUserDefault = in.Get("new_user_default").(bool)
+
+	if v, ok := in.Get("rules").([]interface{}); ok && len(v) > 0 {
+		obj.Rules = expandPolicyRules(v)
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
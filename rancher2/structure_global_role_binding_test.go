@@ -0,0 +1,80 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testGlobalRoleBindingConf      *GlobalRoleBinding
+	testGlobalRoleBindingInterface map[string]interface{}
+)
+
+func init() {
+	testGlobalRoleBindingConf = &GlobalRoleBinding{
+		GlobalRoleBinding: managementClient.GlobalRoleBinding{
+			GlobalRoleID: "global-role-test",
+			Name:         "test",
+			UserID:       "user-test",
+		},
+	}
+	testGlobalRoleBindingInterface = map[string]interface{}{
+		"global_role_id": "global-role-test",
+		"name":           "test",
+		"user_id":        "user-test",
+	}
+}
+
+func TestFlattenGlobalRoleBinding(t *testing.T) {
+
+	cases := []struct {
+		Input          *GlobalRoleBinding
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testGlobalRoleBindingConf,
+			testGlobalRoleBindingInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, globalRoleBindingFields(), map[string]interface{}{})
+		err := flattenGlobalRoleBinding(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandGlobalRoleBinding(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *GlobalRoleBinding
+	}{
+		{
+			testGlobalRoleBindingInterface,
+			testGlobalRoleBindingConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, globalRoleBindingFields(), tc.Input)
+		output := expandGlobalRoleBinding(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
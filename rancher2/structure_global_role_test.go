@@ -0,0 +1,96 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testGlobalRoleConf      *managementClient.GlobalRole
+	testGlobalRoleInterface map[string]interface{}
+)
+
+func init() {
+	testGlobalRoleConf = &managementClient.GlobalRole{
+		Name:           "global-role-test",
+		Description:    "description",
+		NewUserDefault: true,
+		Rules: []managementClient.PolicyRule{
+			{
+				APIGroups:       []string{"group1", "group2"},
+				NonResourceURLs: []string{"url1", "url2"},
+				ResourceNames:   []string{"name1", "name2"},
+				Resources:       []string{"resource1", "resource2"},
+				Verbs:           []string{"get", "list"},
+			},
+		},
+	}
+	testGlobalRoleInterface = map[string]interface{}{
+		"name":             "global-role-test",
+		"description":      "description",
+		"new_user_default": true,
+		"rules": []interface{}{
+			map[string]interface{}{
+				"api_groups":        []interface{}{"group1", "group2"},
+				"non_resource_urls": []interface{}{"url1", "url2"},
+				"resource_names":    []interface{}{"name1", "name2"},
+				"resources":         []interface{}{"resource1", "resource2"},
+				"verbs":             []interface{}{"get", "list"},
+			},
+		},
+	}
+}
+
+func TestFlattenGlobalRole(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.GlobalRole
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testGlobalRoleConf,
+			testGlobalRoleInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, globalRoleFields(), map[string]interface{}{})
+		err := flattenGlobalRole(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandGlobalRole(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *managementClient.GlobalRole
+	}{
+		{
+			testGlobalRoleInterface,
+			testGlobalRoleConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, globalRoleFields(), tc.Input)
+		output := expandGlobalRole(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
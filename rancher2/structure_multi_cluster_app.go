@@ -0,0 +1,337 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenMultiClusterAppAnswers(in []managementClient.Answer) []interface{} {
+	if len(in) == 0 {
+		return []interface{}{}
+	}
+
+	out := make([]interface{}, len(in))
+	for i, v := range in {
+		obj := make(map[string]interface{})
+
+		if len(v.ClusterID) > 0 {
+			obj["cluster_id"] = v.ClusterID
+		}
+
+		if len(v.ProjectID) > 0 {
+			obj["project_id"] = v.ProjectID
+		}
+
+		if len(v.Values) > 0 {
+			obj["values"] = toMapInterface(v.Values)
+		}
+
+		out[i] = obj
+	}
+
+	return out
+}
+
+func flattenMultiClusterAppMembers(in []managementClient.Member) []interface{} {
+	if len(in) == 0 {
+		return []interface{}{}
+	}
+
+	out := make([]interface{}, len(in))
+	for i, v := range in {
+		obj := make(map[string]interface{})
+
+		if len(v.AccessType) > 0 {
+			obj["access_type"] = v.AccessType
+		}
+
+		if len(v.GroupPrincipalID) > 0 {
+			obj["group_principal_id"] = v.GroupPrincipalID
+		}
+
+		if len(v.UserPrincipalID) > 0 {
+			obj["user_principal_id"] = v.UserPrincipalID
+		}
+
+		out[i] = obj
+	}
+
+	return out
+}
+
+func flattenMultiClusterAppTargets(in []managementClient.Target) []interface{} {
+	if len(in) == 0 {
+		return []interface{}{}
+	}
+
+	out := make([]interface{}, len(in))
+	for i, v := range in {
+		obj := make(map[string]interface{})
+
+		obj["project_id"] = v.ProjectID
+
+		if len(v.AppID) > 0 {
+			obj["app_id"] = v.AppID
+		}
+
+		if len(v.Healthstate) > 0 {
+			obj["health_state"] = v.Healthstate
+		}
+
+		if len(v.State) > 0 {
+			obj["state"] = v.State
+		}
+
+		out[i] = obj
+	}
+
+	return out
+}
+
+func flattenMultiClusterAppUpgradeStrategy(in *managementClient.UpgradeStrategy) []interface{} {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if in.RollingUpdate != nil {
+		rollingUpdate := map[string]interface{}{
+			"batch_size": int(in.RollingUpdate.BatchSize),
+			"interval":   int(in.RollingUpdate.Interval),
+		}
+		obj["rolling_update"] = []interface{}{rollingUpdate}
+	}
+
+	return []interface{}{obj}
+}
+
+// flattenMultiClusterApp needs externalID, the app template version external ID, to set catalog, template and version
+func flattenMultiClusterApp(d *schema.ResourceData, in *managementClient.MultiClusterApp, externalID string) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("name", in.Name)
+	d.Set("template_version_id", in.TemplateVersionID)
+	d.Set("revision_history_limit", int(in.RevisionHistoryLimit))
+
+	if in.Status != nil {
+		d.Set("revision_id", in.Status.RevisionID)
+	}
+
+	catalog, template, version, err := splitAppExternalID(externalID)
+	if err != nil {
+		return err
+	}
+	d.Set("catalog_name", catalog)
+	d.Set("template_name", template)
+	d.Set("template_version", version)
+
+	err = d.Set("targets", flattenMultiClusterAppTargets(in.Targets))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("answers", flattenMultiClusterAppAnswers(in.Answers))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("members", flattenMultiClusterAppMembers(in.Members))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("roles", toArrayInterface(in.Roles))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("upgrade_strategy", flattenMultiClusterAppUpgradeStrategy(in.UpgradeStrategy))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Expanders
+
+func expandMultiClusterAppAnswers(p []interface{}) []managementClient.Answer {
+	if len(p) == 0 || p[0] == nil {
+		return []managementClient.Answer{}
+	}
+
+	obj := make([]managementClient.Answer, len(p))
+	for i := range p {
+		in := p[i].(map[string]interface{})
+
+		if v, ok := in["cluster_id"].(string); ok && len(v) > 0 {
+			obj[i].ClusterID = v
+		}
+
+		if v, ok := in["project_id"].(string); ok && len(v) > 0 {
+			obj[i].ProjectID = v
+		}
+
+		if v, ok := in["values"].(map[string]interface{}); ok && len(v) > 0 {
+			obj[i].Values = toMapString(v)
+		}
+	}
+
+	return obj
+}
+
+func expandMultiClusterAppMembers(p []interface{}) []managementClient.Member {
+	if len(p) == 0 || p[0] == nil {
+		return []managementClient.Member{}
+	}
+
+	obj := make([]managementClient.Member, len(p))
+	for i := range p {
+		in := p[i].(map[string]interface{})
+
+		if v, ok := in["access_type"].(string); ok && len(v) > 0 {
+			obj[i].AccessType = v
+		}
+
+		if v, ok := in["group_principal_id"].(string); ok && len(v) > 0 {
+			obj[i].GroupPrincipalID = v
+		}
+
+		if v, ok := in["user_principal_id"].(string); ok && len(v) > 0 {
+			obj[i].UserPrincipalID = v
+		}
+	}
+
+	return obj
+}
+
+func expandMultiClusterAppTargets(p []interface{}) []managementClient.Target {
+	if len(p) == 0 || p[0] == nil {
+		return []managementClient.Target{}
+	}
+
+	obj := make([]managementClient.Target, len(p))
+	for i := range p {
+		in := p[i].(map[string]interface{})
+
+		obj[i].ProjectID = in["project_id"].(string)
+	}
+
+	return obj
+}
+
+func expandMultiClusterAppUpgradeStrategy(p []interface{}) *managementClient.UpgradeStrategy {
+	obj := &managementClient.UpgradeStrategy{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["rolling_update"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rollingUpdate := v[0].(map[string]interface{})
+		obj.RollingUpdate = &managementClient.RollingUpdate{
+			BatchSize: int64(rollingUpdate["batch_size"].(int)),
+			Interval:  int64(rollingUpdate["interval"].(int)),
+		}
+	}
+
+	return obj
+}
+
+func expandMultiClusterAppTemplateVersionID(in *schema.ResourceData) string {
+	return multiClusterAppTemplateVersionIDPrefix + in.Get("catalog_name").(string) + "-" + in.Get("template_name").(string) + "-" + in.Get("template_version").(string)
+}
+
+func expandMultiClusterApp(in *schema.ResourceData) *managementClient.MultiClusterApp {
+	obj := &managementClient.MultiClusterApp{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.Name = in.Get("name").(string)
+	obj.TemplateVersionID = expandMultiClusterAppTemplateVersionID(in)
+	obj.RevisionHistoryLimit = int64(in.Get("revision_history_limit").(int))
+
+	if v, ok := in.Get("targets").([]interface{}); ok && len(v) > 0 {
+		obj.Targets = expandMultiClusterAppTargets(v)
+	}
+
+	if v, ok := in.Get("answers").([]interface{}); ok && len(v) > 0 {
+		obj.Answers = expandMultiClusterAppAnswers(v)
+	}
+
+	if v, ok := in.Get("members").([]interface{}); ok && len(v) > 0 {
+		obj.Members = expandMultiClusterAppMembers(v)
+	}
+
+	if v, ok := in.Get("roles").([]interface{}); ok && len(v) > 0 {
+		obj.Roles = toArrayString(v)
+	}
+
+	if v, ok := in.Get("upgrade_strategy").([]interface{}); ok && len(v) > 0 {
+		obj.UpgradeStrategy = expandMultiClusterAppUpgradeStrategy(v)
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
+
+// expandMultiClusterAppTargetsUpdate returns the inputs to add and remove projects from old to new targets.
+// Added projects get their project answers, so existing targets aren't redeployed
+func expandMultiClusterAppTargetsUpdate(old, new, answers []interface{}) (*managementClient.UpdateMultiClusterAppTargetsInput, *managementClient.UpdateMultiClusterAppTargetsInput) {
+	oldProjects := map[string]bool{}
+	for _, target := range expandMultiClusterAppTargets(old) {
+		oldProjects[target.ProjectID] = true
+	}
+	newProjects := map[string]bool{}
+	for _, target := range expandMultiClusterAppTargets(new) {
+		newProjects[target.ProjectID] = true
+	}
+
+	add := &managementClient.UpdateMultiClusterAppTargetsInput{}
+	for _, target := range expandMultiClusterAppTargets(new) {
+		if !oldProjects[target.ProjectID] {
+			add.Projects = append(add.Projects, target.ProjectID)
+		}
+	}
+	for _, answer := range expandMultiClusterAppAnswers(answers) {
+		if len(answer.ProjectID) > 0 && newProjects[answer.ProjectID] && !oldProjects[answer.ProjectID] {
+			add.Answers = append(add.Answers, answer)
+		}
+	}
+
+	remove := &managementClient.UpdateMultiClusterAppTargetsInput{}
+	for _, target := range expandMultiClusterAppTargets(old) {
+		if !newProjects[target.ProjectID] {
+			remove.Projects = append(remove.Projects, target.ProjectID)
+		}
+	}
+
+	return add, remove
+}
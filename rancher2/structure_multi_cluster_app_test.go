@@ -0,0 +1,222 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testMultiClusterAppConf       *managementClient.MultiClusterApp
+	testMultiClusterAppInterface  map[string]interface{}
+	testMultiClusterAppExternalID string
+)
+
+func init() {
+	testMultiClusterAppConf = &managementClient.MultiClusterApp{
+		Name:                 "foo",
+		TemplateVersionID:    "cattle-global-data:library-docker-registry-1.6.1",
+		RevisionHistoryLimit: 5,
+		Roles:                []string{"project-member"},
+		Targets: []managementClient.Target{
+			{
+				ProjectID: "c-test:p-test",
+			},
+			{
+				ProjectID: "c-test:p-test2",
+			},
+		},
+		Answers: []managementClient.Answer{
+			{
+				Values: map[string]string{
+					"ingress_host": "test.xip.io",
+				},
+			},
+			{
+				ProjectID: "c-test:p-test2",
+				Values: map[string]string{
+					"ingress_host": "test2.xip.io",
+				},
+			},
+		},
+		Members: []managementClient.Member{
+			{
+				AccessType:      "owner",
+				UserPrincipalID: "local://u-test",
+			},
+		},
+		UpgradeStrategy: &managementClient.UpgradeStrategy{
+			RollingUpdate: &managementClient.RollingUpdate{
+				BatchSize: 2,
+				Interval:  10,
+			},
+		},
+		Annotations: map[string]string{
+			"node_one": "one",
+			"node_two": "two",
+		},
+		Labels: map[string]string{
+			"option1": "value1",
+			"option2": "value2",
+		},
+	}
+	testMultiClusterAppExternalID = "catalog://?catalog=library&template=docker-registry&version=1.6.1"
+	testMultiClusterAppInterface = map[string]interface{}{
+		"name":                   "foo",
+		"catalog_name":           "library",
+		"template_name":          "docker-registry",
+		"template_version":       "1.6.1",
+		"revision_history_limit": 5,
+		"roles":                  []interface{}{"project-member"},
+		"targets": []interface{}{
+			map[string]interface{}{
+				"project_id":   "c-test:p-test",
+				"app_id":       "",
+				"health_state": "",
+				"state":        "",
+			},
+			map[string]interface{}{
+				"project_id":   "c-test:p-test2",
+				"app_id":       "",
+				"health_state": "",
+				"state":        "",
+			},
+		},
+		"answers": []interface{}{
+			map[string]interface{}{
+				"cluster_id": "",
+				"project_id": "",
+				"values": map[string]interface{}{
+					"ingress_host": "test.xip.io",
+				},
+			},
+			map[string]interface{}{
+				"cluster_id": "",
+				"project_id": "c-test:p-test2",
+				"values": map[string]interface{}{
+					"ingress_host": "test2.xip.io",
+				},
+			},
+		},
+		"members": []interface{}{
+			map[string]interface{}{
+				"access_type":        "owner",
+				"group_principal_id": "",
+				"user_principal_id":  "local://u-test",
+			},
+		},
+		"upgrade_strategy": []interface{}{
+			map[string]interface{}{
+				"rolling_update": []interface{}{
+					map[string]interface{}{
+						"batch_size": 2,
+						"interval":   10,
+					},
+				},
+			},
+		},
+		"annotations": map[string]interface{}{
+			"node_one": "one",
+			"node_two": "two",
+		},
+		"labels": map[string]interface{}{
+			"option1": "value1",
+			"option2": "value2",
+		},
+	}
+}
+
+func TestFlattenMultiClusterApp(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.MultiClusterApp
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testMultiClusterAppConf,
+			testMultiClusterAppInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, multiClusterAppFields(), map[string]interface{}{})
+		err := flattenMultiClusterApp(output, tc.Input, testMultiClusterAppExternalID)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandMultiClusterApp(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *managementClient.MultiClusterApp
+	}{
+		{
+			testMultiClusterAppInterface,
+			testMultiClusterAppConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, multiClusterAppFields(), tc.Input)
+		output := expandMultiClusterApp(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandMultiClusterAppTargetsUpdate(t *testing.T) {
+	targets := testMultiClusterAppInterface["targets"].([]interface{})
+	answers := testMultiClusterAppInterface["answers"].([]interface{})
+
+	cases := []struct {
+		Old            []interface{}
+		New            []interface{}
+		ExpectedAdd    *managementClient.UpdateMultiClusterAppTargetsInput
+		ExpectedRemove *managementClient.UpdateMultiClusterAppTargetsInput
+	}{
+		{
+			targets[:1],
+			targets,
+			&managementClient.UpdateMultiClusterAppTargetsInput{
+				Projects: []string{"c-test:p-test2"},
+				Answers:  testMultiClusterAppConf.Answers[1:],
+			},
+			&managementClient.UpdateMultiClusterAppTargetsInput{},
+		},
+		{
+			targets,
+			targets[1:],
+			&managementClient.UpdateMultiClusterAppTargetsInput{},
+			&managementClient.UpdateMultiClusterAppTargetsInput{
+				Projects: []string{"c-test:p-test"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		add, remove := expandMultiClusterAppTargetsUpdate(tc.Old, tc.New, answers)
+		if !reflect.DeepEqual(add, tc.ExpectedAdd) {
+			t.Fatalf("Unexpected add output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedAdd, add)
+		}
+		if !reflect.DeepEqual(remove, tc.ExpectedRemove) {
+			t.Fatalf("Unexpected remove output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedRemove, remove)
+		}
+	}
+}
@@ -7,6 +7,35 @@ import (
 
 // Flatteners
 
+func flattenNamespaceContainerResourceLimit(in *clusterClient.ContainerResourceLimit) []interface{} {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if len(in.LimitsCPU) > 0 {
+		obj["limits_cpu"] = in.LimitsCPU
+	}
+
+	if len(in.LimitsMemory) > 0 {
+		obj["limits_memory"] = in.LimitsMemory
+	}
+
+	if len(in.RequestsCPU) > 0 {
+		obj["requests_cpu"] = in.RequestsCPU
+	}
+
+	if len(in.RequestsMemory) > 0 {
+		obj["requests_memory"] = in.RequestsMemory
+	}
+
+	if len(obj) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{obj}
+}
+
 func flattenNamespaceResourceQuotaLimit(in *clusterClient.ResourceQuotaLimit) []interface{} {
 	obj := make(map[string]interface{})
 	if in == nil {
@@ -96,6 +125,11 @@ func flattenNamespace(d *schema.ResourceData, in *clusterClient.Namespace) error
 	d.Set("name", in.Name)
 	d.Set("description", in.Description)
 
+	err := d.Set("container_resource_limit", flattenNamespaceContainerResourceLimit(in.ContainerDefaultResourceLimit))
+	if err != nil {
+		return err
+	}
+
 	if in.ResourceQuota != nil {
 		resourceQuota := flattenNamespaceResourceQuota(in.ResourceQuota)
 		err := d.Set("resource_quota", resourceQuota)
@@ -104,7 +138,7 @@ func flattenNamespace(d *schema.ResourceData, in *clusterClient.Namespace) error
 		}
 	}
 
-	err := d.Set("annotations", toMapInterface(in.Annotations))
+	err = d.Set("annotations", toMapInterface(in.Annotations))
 	if err != nil {
 		return err
 	}
@@ -120,6 +154,33 @@ func flattenNamespace(d *schema.ResourceData, in *clusterClient.Namespace) error
 
 // Expanders
 
+func expandNamespaceContainerResourceLimit(p []interface{}) *clusterClient.ContainerResourceLimit {
+	obj := &clusterClient.ContainerResourceLimit{}
+
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["limits_cpu"].(string); ok && len(v) > 0 {
+		obj.LimitsCPU = v
+	}
+
+	if v, ok := in["limits_memory"].(string); ok && len(v) > 0 {
+		obj.LimitsMemory = v
+	}
+
+	if v, ok := in["requests_cpu"].(string); ok && len(v) > 0 {
+		obj.RequestsCPU = v
+	}
+
+	if v, ok := in["requests_memory"].(string); ok && len(v) > 0 {
+		obj.RequestsMemory = v
+	}
+
+	return obj
+}
+
 func expandNamespaceResourceQuotaLimit(p []interface{}) *clusterClient.ResourceQuotaLimit {
 	obj := &clusterClient.ResourceQuotaLimit{}
 
@@ -214,6 +275,10 @@ func expandNamespace(in *schema.ResourceData) *clusterClient.Namespace {
 	obj.Name = in.Get("name").(string)
 	obj.Description = in.Get("description").(string)
 
+	if v, ok := in.Get("container_resource_limit").([]interface{}); ok && len(v) > 0 {
+		obj.ContainerDefaultResourceLimit = expandNamespaceContainerResourceLimit(v)
+	}
+
 	if v, ok := in.Get("resource_quota").([]interface{}); ok && len(v) > 0 {
 		resourceQuota := expandNamespaceResourceQuota(v)
 		obj.ResourceQuota = resourceQuota
@@ -9,15 +9,31 @@ import (
 )
 
 var (
-	testNamespaceResourceQuotaLimitConf      *clusterClient.ResourceQuotaLimit
-	testNamespaceResourceQuotaLimitInterface []interface{}
-	testNamespaceResourceQuotaConf           *clusterClient.NamespaceResourceQuota
-	testNamespaceResourceQuotaInterface      []interface{}
-	testNamespaceConf                        *clusterClient.Namespace
-	testNamespaceInterface                   map[string]interface{}
+	testNamespaceContainerResourceLimitConf      *clusterClient.ContainerResourceLimit
+	testNamespaceContainerResourceLimitInterface []interface{}
+	testNamespaceResourceQuotaLimitConf          *clusterClient.ResourceQuotaLimit
+	testNamespaceResourceQuotaLimitInterface     []interface{}
+	testNamespaceResourceQuotaConf               *clusterClient.NamespaceResourceQuota
+	testNamespaceResourceQuotaInterface          []interface{}
+	testNamespaceConf                            *clusterClient.Namespace
+	testNamespaceInterface                       map[string]interface{}
 )
 
 func init() {
+	testNamespaceContainerResourceLimitConf = &clusterClient.ContainerResourceLimit{
+		LimitsCPU:      "cpu",
+		LimitsMemory:   "memory",
+		RequestsCPU:    "r_cpu",
+		RequestsMemory: "r_memory",
+	}
+	testNamespaceContainerResourceLimitInterface = []interface{}{
+		map[string]interface{}{
+			"limits_cpu":      "cpu",
+			"limits_memory":   "memory",
+			"requests_cpu":    "r_cpu",
+			"requests_memory": "r_memory",
+		},
+	}
 	testNamespaceResourceQuotaLimitConf = &clusterClient.ResourceQuotaLimit{
 		ConfigMaps:             "config",
 		LimitsCPU:              "cpu",
@@ -59,16 +75,43 @@ func init() {
 		},
 	}
 	testNamespaceConf = &clusterClient.Namespace{
-		ProjectID:     "project:test",
-		Name:          "test",
-		Description:   "description",
-		ResourceQuota: testNamespaceResourceQuotaConf,
+		ProjectID:                     "project:test",
+		Name:                          "test",
+		Description:                   "description",
+		ContainerDefaultResourceLimit: testNamespaceContainerResourceLimitConf,
+		ResourceQuota:                 testNamespaceResourceQuotaConf,
 	}
 	testNamespaceInterface = map[string]interface{}{
-		"project_id":     "project:test",
-		"name":           "test",
-		"description":    "description",
-		"resource_quota": testNamespaceResourceQuotaInterface,
+		"project_id":               "project:test",
+		"name":                     "test",
+		"description":              "description",
+		"container_resource_limit": testNamespaceContainerResourceLimitInterface,
+		"resource_quota":           testNamespaceResourceQuotaInterface,
+	}
+}
+
+func TestFlattenNamespaceContainerResourceLimit(t *testing.T) {
+
+	cases := []struct {
+		Input          *clusterClient.ContainerResourceLimit
+		ExpectedOutput []interface{}
+	}{
+		{
+			testNamespaceContainerResourceLimitConf,
+			testNamespaceContainerResourceLimitInterface,
+		},
+		{
+			&clusterClient.ContainerResourceLimit{},
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenNamespaceContainerResourceLimit(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
 	}
 }
 
@@ -143,6 +186,27 @@ func TestFlattenNamespace(t *testing.T) {
 	}
 }
 
+func TestExpandNamespaceContainerResourceLimit(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *clusterClient.ContainerResourceLimit
+	}{
+		{
+			testNamespaceContainerResourceLimitInterface,
+			testNamespaceContainerResourceLimitConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandNamespaceContainerResourceLimit(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
 func TestExpandNamespaceResourceQuotaLimit(t *testing.T) {
 
 	cases := []struct {
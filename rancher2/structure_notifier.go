@@ -0,0 +1,133 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenNotifier(d *schema.ResourceData, in *managementClient.Notifier) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("cluster_id", in.ClusterID)
+	d.Set("name", in.Name)
+	d.Set("description", in.Description)
+
+	if in.PagerdutyConfig != nil {
+		v, ok := d.Get("pagerduty_config").([]interface{})
+		if !ok {
+			v = []interface{}{}
+		}
+		err := d.Set("pagerduty_config", flattenNotifierPagerdutyConfig(in.PagerdutyConfig, v))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.SlackConfig != nil {
+		v, ok := d.Get("slack_config").([]interface{})
+		if !ok {
+			v = []interface{}{}
+		}
+		err := d.Set("slack_config", flattenNotifierSlackConfig(in.SlackConfig, v))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.SMTPConfig != nil {
+		v, ok := d.Get("smtp_config").([]interface{})
+		if !ok {
+			v = []interface{}{}
+		}
+		err := d.Set("smtp_config", flattenNotifierSMTPConfig(in.SMTPConfig, v))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.WebhookConfig != nil {
+		v, ok := d.Get("webhook_config").([]interface{})
+		if !ok {
+			v = []interface{}{}
+		}
+		err := d.Set("webhook_config", flattenNotifierWebhookConfig(in.WebhookConfig, v))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.WechatConfig != nil {
+		v, ok := d.Get("wechat_config").([]interface{})
+		if !ok {
+			v = []interface{}{}
+		}
+		err := d.Set("wechat_config", flattenNotifierWechatConfig(in.WechatConfig, v))
+		if err != nil {
+			return err
+		}
+	}
+
+	err := d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Expanders
+
+func expandNotifier(in *schema.ResourceData) *managementClient.Notifier {
+	obj := &managementClient.Notifier{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.ClusterID = in.Get("cluster_id").(string)
+	obj.Name = in.Get("name").(string)
+	obj.Description = in.Get("description").(string)
+
+	if v, ok := in.Get("pagerduty_config").([]interface{}); ok && len(v) > 0 {
+		obj.PagerdutyConfig = expandNotifierPagerdutyConfig(v)
+	}
+
+	if v, ok := in.Get("slack_config").([]interface{}); ok && len(v) > 0 {
+		obj.SlackConfig = expandNotifierSlackConfig(v)
+	}
+
+	if v, ok := in.Get("smtp_config").([]interface{}); ok && len(v) > 0 {
+		obj.SMTPConfig = expandNotifierSMTPConfig(v)
+	}
+
+	if v, ok := in.Get("webhook_config").([]interface{}); ok && len(v) > 0 {
+		obj.WebhookConfig = expandNotifierWebhookConfig(v)
+	}
+
+	if v, ok := in.Get("wechat_config").([]interface{}); ok && len(v) > 0 {
+		obj.WechatConfig = expandNotifierWechatConfig(v)
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
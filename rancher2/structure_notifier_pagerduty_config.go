@@ -0,0 +1,43 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenNotifierPagerdutyConfig(in *managementClient.PagerdutyConfig, p []interface{}) []interface{} {
+	var obj map[string]interface{}
+	if len(p) == 0 || p[0] == nil {
+		obj = make(map[string]interface{})
+	} else {
+		obj = p[0].(map[string]interface{})
+	}
+
+	if in == nil {
+		return []interface{}{}
+	}
+
+	// Rancher may not return the service key, keeping the one on state
+	if len(in.ServiceKey) > 0 {
+		obj["service_key"] = in.ServiceKey
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandNotifierPagerdutyConfig(p []interface{}) *managementClient.PagerdutyConfig {
+	obj := &managementClient.PagerdutyConfig{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["service_key"].(string); ok && len(v) > 0 {
+		obj.ServiceKey = v
+	}
+
+	return obj
+}
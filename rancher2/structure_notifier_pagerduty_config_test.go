@@ -0,0 +1,66 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testNotifierPagerdutyConfigConf      *managementClient.PagerdutyConfig
+	testNotifierPagerdutyConfigInterface []interface{}
+)
+
+func init() {
+	testNotifierPagerdutyConfigConf = &managementClient.PagerdutyConfig{
+		ServiceKey: "XXXXXXXX",
+	}
+	testNotifierPagerdutyConfigInterface = []interface{}{
+		map[string]interface{}{
+			"service_key": "XXXXXXXX",
+		},
+	}
+}
+
+func TestFlattenNotifierPagerdutyConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.PagerdutyConfig
+		ExpectedOutput []interface{}
+	}{
+		{
+			testNotifierPagerdutyConfigConf,
+			testNotifierPagerdutyConfigInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenNotifierPagerdutyConfig(tc.Input, []interface{}{})
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandNotifierPagerdutyConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.PagerdutyConfig
+	}{
+		{
+			testNotifierPagerdutyConfigInterface,
+			testNotifierPagerdutyConfigConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandNotifierPagerdutyConfig(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testNotifierSlackConfigConf      *managementClient.SlackConfig
+	testNotifierSlackConfigInterface []interface{}
+)
+
+func init() {
+	testNotifierSlackConfigConf = &managementClient.SlackConfig{
+		DefaultRecipient: "#foo",
+		URL:              "http://foo.com",
+	}
+	testNotifierSlackConfigInterface = []interface{}{
+		map[string]interface{}{
+			"default_recipient": "#foo",
+			"url":               "http://foo.com",
+		},
+	}
+}
+
+func TestFlattenNotifierSlackConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.SlackConfig
+		ExpectedOutput []interface{}
+	}{
+		{
+			testNotifierSlackConfigConf,
+			testNotifierSlackConfigInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenNotifierSlackConfig(tc.Input, []interface{}{})
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandNotifierSlackConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.SlackConfig
+	}{
+		{
+			testNotifierSlackConfigInterface,
+			testNotifierSlackConfigConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandNotifierSlackConfig(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenNotifierSMTPConfig(in *managementClient.SMTPConfig, p []interface{}) []interface{} {
+	var obj map[string]interface{}
+	if len(p) == 0 || p[0] == nil {
+		obj = make(map[string]interface{})
+	} else {
+		obj = p[0].(map[string]interface{})
+	}
+
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if len(in.DefaultRecipient) > 0 {
+		obj["default_recipient"] = in.DefaultRecipient
+	}
+
+	if len(in.Host) > 0 {
+		obj["host"] = in.Host
+	}
+
+	if in.Port > 0 {
+		obj["port"] = int(in.Port)
+	}
+
+	if len(in.Sender) > 0 {
+		obj["sender"] = in.Sender
+	}
+
+	// Rancher may not return the password, keeping the one on state
+	if len(in.Password) > 0 {
+		obj["password"] = in.Password
+	}
+
+	obj["tls"] = in.TLS
+
+	if len(in.Username) > 0 {
+		obj["username"] = in.Username
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandNotifierSMTPConfig(p []interface{}) *managementClient.SMTPConfig {
+	obj := &managementClient.SMTPConfig{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["default_recipient"].(string); ok && len(v) > 0 {
+		obj.DefaultRecipient = v
+	}
+
+	if v, ok := in["host"].(string); ok && len(v) > 0 {
+		obj.Host = v
+	}
+
+	if v, ok := in["port"].(int); ok && v > 0 {
+		obj.Port = int64(v)
+	}
+
+	if v, ok := in["sender"].(string); ok && len(v) > 0 {
+		obj.Sender = v
+	}
+
+	if v, ok := in["password"].(string); ok && len(v) > 0 {
+		obj.Password = v
+	}
+
+	if v, ok := in["tls"].(bool); ok {
+		obj.TLS = v
+	}
+
+	if v, ok := in["username"].(string); ok && len(v) > 0 {
+		obj.Username = v
+	}
+
+	return obj
+}
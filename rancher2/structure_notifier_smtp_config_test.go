@@ -0,0 +1,78 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testNotifierSMTPConfigConf      *managementClient.SMTPConfig
+	testNotifierSMTPConfigInterface []interface{}
+)
+
+func init() {
+	testNotifierSMTPConfigConf = &managementClient.SMTPConfig{
+		DefaultRecipient: "foo@foo.com",
+		Host:             "smtp.foo.com",
+		Port:             587,
+		Sender:           "rancher@foo.com",
+		Password:         "pass",
+		TLS:              true,
+		Username:         "user",
+	}
+	testNotifierSMTPConfigInterface = []interface{}{
+		map[string]interface{}{
+			"default_recipient": "foo@foo.com",
+			"host":              "smtp.foo.com",
+			"port":              587,
+			"sender":            "rancher@foo.com",
+			"password":          "pass",
+			"tls":               true,
+			"username":          "user",
+		},
+	}
+}
+
+func TestFlattenNotifierSMTPConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.SMTPConfig
+		ExpectedOutput []interface{}
+	}{
+		{
+			testNotifierSMTPConfigConf,
+			testNotifierSMTPConfigInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenNotifierSMTPConfig(tc.Input, []interface{}{})
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandNotifierSMTPConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.SMTPConfig
+	}{
+		{
+			testNotifierSMTPConfigInterface,
+			testNotifierSMTPConfigConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandNotifierSMTPConfig(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
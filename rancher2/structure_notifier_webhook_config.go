@@ -0,0 +1,42 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenNotifierWebhookConfig(in *managementClient.WebhookConfig, p []interface{}) []interface{} {
+	var obj map[string]interface{}
+	if len(p) == 0 || p[0] == nil {
+		obj = make(map[string]interface{})
+	} else {
+		obj = p[0].(map[string]interface{})
+	}
+
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if len(in.URL) > 0 {
+		obj["url"] = in.URL
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandNotifierWebhookConfig(p []interface{}) *managementClient.WebhookConfig {
+	obj := &managementClient.WebhookConfig{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["url"].(string); ok && len(v) > 0 {
+		obj.URL = v
+	}
+
+	return obj
+}
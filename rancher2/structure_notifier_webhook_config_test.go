@@ -0,0 +1,66 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testNotifierWebhookConfigConf      *managementClient.WebhookConfig
+	testNotifierWebhookConfigInterface []interface{}
+)
+
+func init() {
+	testNotifierWebhookConfigConf = &managementClient.WebhookConfig{
+		URL: "http://foo.com",
+	}
+	testNotifierWebhookConfigInterface = []interface{}{
+		map[string]interface{}{
+			"url": "http://foo.com",
+		},
+	}
+}
+
+func TestFlattenNotifierWebhookConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.WebhookConfig
+		ExpectedOutput []interface{}
+	}{
+		{
+			testNotifierWebhookConfigConf,
+			testNotifierWebhookConfigInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenNotifierWebhookConfig(tc.Input, []interface{}{})
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandNotifierWebhookConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.WebhookConfig
+	}{
+		{
+			testNotifierWebhookConfigInterface,
+			testNotifierWebhookConfigConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandNotifierWebhookConfig(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenNotifierWechatConfig(in *managementClient.WechatConfig, p []interface{}) []interface{} {
+	var obj map[string]interface{}
+	if len(p) == 0 || p[0] == nil {
+		obj = make(map[string]interface{})
+	} else {
+		obj = p[0].(map[string]interface{})
+	}
+
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if len(in.Agent) > 0 {
+		obj["agent"] = in.Agent
+	}
+
+	if len(in.Corp) > 0 {
+		obj["corp"] = in.Corp
+	}
+
+	if len(in.DefaultRecipient) > 0 {
+		obj["default_recipient"] = in.DefaultRecipient
+	}
+
+	// Rancher may not return the secret, keeping the one on state
+	if len(in.Secret) > 0 {
+		obj["secret"] = in.Secret
+	}
+
+	if len(in.RecipientType) > 0 {
+		obj["recipient_type"] = in.RecipientType
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandNotifierWechatConfig(p []interface{}) *managementClient.WechatConfig {
+	obj := &managementClient.WechatConfig{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["agent"].(string); ok && len(v) > 0 {
+		obj.Agent = v
+	}
+
+	if v, ok := in["corp"].(string); ok && len(v) > 0 {
+		obj.Corp = v
+	}
+
+	if v, ok := in["default_recipient"].(string); ok && len(v) > 0 {
+		obj.DefaultRecipient = v
+	}
+
+	if v, ok := in["secret"].(string); ok && len(v) > 0 {
+		obj.Secret = v
+	}
+
+	if v, ok := in["recipient_type"].(string); ok && len(v) > 0 {
+		obj.RecipientType = v
+	}
+
+	return obj
+}
@@ -0,0 +1,74 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testNotifierWechatConfigConf      *managementClient.WechatConfig
+	testNotifierWechatConfigInterface []interface{}
+)
+
+func init() {
+	testNotifierWechatConfigConf = &managementClient.WechatConfig{
+		Agent:            "agent",
+		Corp:             "corp",
+		DefaultRecipient: "foo",
+		Secret:           "XXXXXXXX",
+		RecipientType:    "party",
+	}
+	testNotifierWechatConfigInterface = []interface{}{
+		map[string]interface{}{
+			"agent":             "agent",
+			"corp":              "corp",
+			"default_recipient": "foo",
+			"secret":            "XXXXXXXX",
+			"recipient_type":    "party",
+		},
+	}
+}
+
+func TestFlattenNotifierWechatConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.WechatConfig
+		ExpectedOutput []interface{}
+	}{
+		{
+			testNotifierWechatConfigConf,
+			testNotifierWechatConfigInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenNotifierWechatConfig(tc.Input, []interface{}{})
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandNotifierWechatConfig(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.WechatConfig
+	}{
+		{
+			testNotifierWechatConfigInterface,
+			testNotifierWechatConfigConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandNotifierWechatConfig(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testNotifierConfPagerduty      *managementClient.Notifier
+	testNotifierInterfacePagerduty map[string]interface{}
+	testNotifierConfSlack          *managementClient.Notifier
+	testNotifierInterfaceSlack     map[string]interface{}
+	testNotifierConfSMTP           *managementClient.Notifier
+	testNotifierInterfaceSMTP      map[string]interface{}
+	testNotifierConfWebhook        *managementClient.Notifier
+	testNotifierInterfaceWebhook   map[string]interface{}
+	testNotifierConfWechat         *managementClient.Notifier
+	testNotifierInterfaceWechat    map[string]interface{}
+)
+
+func init() {
+	testNotifierConfPagerduty = &managementClient.Notifier{
+		ClusterID:       "cluster-test",
+		Name:            "test",
+		Description:     "description",
+		PagerdutyConfig: testNotifierPagerdutyConfigConf,
+	}
+	testNotifierInterfacePagerduty = map[string]interface{}{
+		"cluster_id":       "cluster-test",
+		"name":             "test",
+		"description":      "description",
+		"pagerduty_config": testNotifierPagerdutyConfigInterface,
+	}
+	testNotifierConfSlack = &managementClient.Notifier{
+		ClusterID:   "cluster-test",
+		Name:        "test",
+		Description: "description",
+		SlackConfig: testNotifierSlackConfigConf,
+	}
+	testNotifierInterfaceSlack = map[string]interface{}{
+		"cluster_id":   "cluster-test",
+		"name":         "test",
+		"description":  "description",
+		"slack_config": testNotifierSlackConfigInterface,
+	}
+	testNotifierConfSMTP = &managementClient.Notifier{
+		ClusterID:   "cluster-test",
+		Name:        "test",
+		Description: "description",
+		SMTPConfig:  testNotifierSMTPConfigConf,
+	}
+	testNotifierInterfaceSMTP = map[string]interface{}{
+		"cluster_id":  "cluster-test",
+		"name":        "test",
+		"description": "description",
+		"smtp_config": testNotifierSMTPConfigInterface,
+	}
+	testNotifierConfWebhook = &managementClient.Notifier{
+		ClusterID:     "cluster-test",
+		Name:          "test",
+		Description:   "description",
+		WebhookConfig: testNotifierWebhookConfigConf,
+	}
+	testNotifierInterfaceWebhook = map[string]interface{}{
+		"cluster_id":     "cluster-test",
+		"name":           "test",
+		"description":    "description",
+		"webhook_config": testNotifierWebhookConfigInterface,
+	}
+	testNotifierConfWechat = &managementClient.Notifier{
+		ClusterID:    "cluster-test",
+		Name:         "test",
+		Description:  "description",
+		WechatConfig: testNotifierWechatConfigConf,
+	}
+	testNotifierInterfaceWechat = map[string]interface{}{
+		"cluster_id":    "cluster-test",
+		"name":          "test",
+		"description":   "description",
+		"wechat_config": testNotifierWechatConfigInterface,
+	}
+}
+
+func TestFlattenNotifier(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.Notifier
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testNotifierConfPagerduty,
+			testNotifierInterfacePagerduty,
+		},
+		{
+			testNotifierConfSlack,
+			testNotifierInterfaceSlack,
+		},
+		{
+			testNotifierConfSMTP,
+			testNotifierInterfaceSMTP,
+		},
+		{
+			testNotifierConfWebhook,
+			testNotifierInterfaceWebhook,
+		},
+		{
+			testNotifierConfWechat,
+			testNotifierInterfaceWechat,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, notifierFields(), tc.ExpectedOutput)
+		err := flattenNotifier(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandNotifier(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *managementClient.Notifier
+	}{
+		{
+			testNotifierInterfacePagerduty,
+			testNotifierConfPagerduty,
+		},
+		{
+			testNotifierInterfaceSlack,
+			testNotifierConfSlack,
+		},
+		{
+			testNotifierInterfaceSMTP,
+			testNotifierConfSMTP,
+		},
+		{
+			testNotifierInterfaceWebhook,
+			testNotifierConfWebhook,
+		},
+		{
+			testNotifierInterfaceWechat,
+			testNotifierConfWechat,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, notifierFields(), tc.Input)
+		output := expandNotifier(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
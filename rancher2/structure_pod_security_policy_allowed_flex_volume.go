@@ -0,0 +1,45 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenPodSecurityPolicyAllowedFlexVolumes(p []managementClient.AllowedFlexVolume) []interface{} {
+	out := []interface{}{}
+
+	for _, in := range p {
+		obj := make(map[string]interface{})
+
+		if len(in.Driver) > 0 {
+			obj["driver"] = in.Driver
+		}
+
+		out = append(out, obj)
+	}
+
+	return out
+}
+
+// Expanders
+
+func expandPodSecurityPolicyAllowedFlexVolumes(p []interface{}) []managementClient.AllowedFlexVolume {
+	out := []managementClient.AllowedFlexVolume{}
+	if len(p) == 0 || p[0] == nil {
+		return out
+	}
+
+	for i := range p {
+		in := p[i].(map[string]interface{})
+		obj := managementClient.AllowedFlexVolume{}
+
+		if v, ok := in["driver"].(string); ok && len(v) > 0 {
+			obj.Driver = v
+		}
+
+		out = append(out, obj)
+	}
+
+	return out
+}
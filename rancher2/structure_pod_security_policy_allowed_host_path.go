@@ -0,0 +1,51 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenPodSecurityPolicyAllowedHostPaths(p []managementClient.AllowedHostPath) []interface{} {
+	out := []interface{}{}
+
+	for _, in := range p {
+		obj := make(map[string]interface{})
+
+		if len(in.PathPrefix) > 0 {
+			obj["path_prefix"] = in.PathPrefix
+		}
+
+		obj["read_only"] = in.ReadOnly
+
+		out = append(out, obj)
+	}
+
+	return out
+}
+
+// Expanders
+
+func expandPodSecurityPolicyAllowedHostPaths(p []interface{}) []managementClient.AllowedHostPath {
+	out := []managementClient.AllowedHostPath{}
+	if len(p) == 0 || p[0] == nil {
+		return out
+	}
+
+	for i := range p {
+		in := p[i].(map[string]interface{})
+		obj := managementClient.AllowedHostPath{}
+
+		if v, ok := in["path_prefix"].(string); ok && len(v) > 0 {
+			obj.PathPrefix = v
+		}
+
+		if v, ok := in["read_only"].(bool); ok {
+			obj.ReadOnly = v
+		}
+
+		out = append(out, obj)
+	}
+
+	return out
+}
@@ -0,0 +1,78 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testPodSecurityPolicyFSGroupConf      *managementClient.FSGroupStrategyOptions
+	testPodSecurityPolicyFSGroupInterface []interface{}
+)
+
+func init() {
+	testPodSecurityPolicyFSGroupConf = &managementClient.FSGroupStrategyOptions{
+		Ranges: []managementClient.IDRange{
+			managementClient.IDRange{
+				Max: 65535,
+				Min: 1,
+			},
+		},
+		Rule: "MustRunAs",
+	}
+	testPodSecurityPolicyFSGroupInterface = []interface{}{
+		map[string]interface{}{
+			"range": []interface{}{
+				map[string]interface{}{
+					"max": 65535,
+					"min": 1,
+				},
+			},
+			"rule": "MustRunAs",
+		},
+	}
+}
+
+func TestFlattenPodSecurityPolicyFSGroup(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.FSGroupStrategyOptions
+		ExpectedOutput []interface{}
+	}{
+		{
+			testPodSecurityPolicyFSGroupConf,
+			testPodSecurityPolicyFSGroupInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenPodSecurityPolicyFSGroup(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandPodSecurityPolicyFSGroup(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.FSGroupStrategyOptions
+	}{
+		{
+			testPodSecurityPolicyFSGroupInterface,
+			testPodSecurityPolicyFSGroupConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandPodSecurityPolicyFSGroup(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
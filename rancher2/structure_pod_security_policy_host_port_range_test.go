@@ -0,0 +1,70 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testPodSecurityPolicyHostPortRangesConf      []managementClient.HostPortRange
+	testPodSecurityPolicyHostPortRangesInterface []interface{}
+)
+
+func init() {
+	testPodSecurityPolicyHostPortRangesConf = []managementClient.HostPortRange{
+		managementClient.HostPortRange{
+			Max: 8080,
+			Min: 80,
+		},
+	}
+	testPodSecurityPolicyHostPortRangesInterface = []interface{}{
+		map[string]interface{}{
+			"max": 8080,
+			"min": 80,
+		},
+	}
+}
+
+func TestFlattenPodSecurityPolicyHostPortRanges(t *testing.T) {
+
+	cases := []struct {
+		Input          []managementClient.HostPortRange
+		ExpectedOutput []interface{}
+	}{
+		{
+			testPodSecurityPolicyHostPortRangesConf,
+			testPodSecurityPolicyHostPortRangesInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenPodSecurityPolicyHostPortRanges(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandPodSecurityPolicyHostPortRanges(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput []managementClient.HostPortRange
+	}{
+		{
+			testPodSecurityPolicyHostPortRangesInterface,
+			testPodSecurityPolicyHostPortRangesConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandPodSecurityPolicyHostPortRanges(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenPodSecurityPolicyIDRanges(p []managementClient.IDRange) []interface{} {
+	out := []interface{}{}
+
+	for _, in := range p {
+		obj := make(map[string]interface{})
+
+		obj["max"] = int(in.Max)
+		obj["min"] = int(in.Min)
+
+		out = append(out, obj)
+	}
+
+	return out
+}
+
+// Expanders
+
+func expandPodSecurityPolicyIDRanges(p []interface{}) []managementClient.IDRange {
+	out := []managementClient.IDRange{}
+	if len(p) == 0 || p[0] == nil {
+		return out
+	}
+
+	for i := range p {
+		in := p[i].(map[string]interface{})
+		obj := managementClient.IDRange{}
+
+		if v, ok := in["max"].(int); ok {
+			obj.Max = int64(v)
+		}
+
+		if v, ok := in["min"].(int); ok {
+			obj.Min = int64(v)
+		}
+
+		out = append(out, obj)
+	}
+
+	return out
+}
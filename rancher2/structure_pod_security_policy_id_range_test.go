@@ -0,0 +1,70 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testPodSecurityPolicyIDRangesConf      []managementClient.IDRange
+	testPodSecurityPolicyIDRangesInterface []interface{}
+)
+
+func init() {
+	testPodSecurityPolicyIDRangesConf = []managementClient.IDRange{
+		managementClient.IDRange{
+			Max: 65535,
+			Min: 1,
+		},
+	}
+	testPodSecurityPolicyIDRangesInterface = []interface{}{
+		map[string]interface{}{
+			"max": 65535,
+			"min": 1,
+		},
+	}
+}
+
+func TestFlattenPodSecurityPolicyIDRanges(t *testing.T) {
+
+	cases := []struct {
+		Input          []managementClient.IDRange
+		ExpectedOutput []interface{}
+	}{
+		{
+			testPodSecurityPolicyIDRangesConf,
+			testPodSecurityPolicyIDRangesInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenPodSecurityPolicyIDRanges(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandPodSecurityPolicyIDRanges(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput []managementClient.IDRange
+	}{
+		{
+			testPodSecurityPolicyIDRangesInterface,
+			testPodSecurityPolicyIDRangesConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandPodSecurityPolicyIDRanges(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
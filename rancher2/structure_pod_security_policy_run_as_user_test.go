@@ -0,0 +1,78 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testPodSecurityPolicyRunAsUserConf      *managementClient.RunAsUserStrategyOptions
+	testPodSecurityPolicyRunAsUserInterface []interface{}
+)
+
+func init() {
+	testPodSecurityPolicyRunAsUserConf = &managementClient.RunAsUserStrategyOptions{
+		Ranges: []managementClient.IDRange{
+			managementClient.IDRange{
+				Max: 65535,
+				Min: 1,
+			},
+		},
+		Rule: "MustRunAs",
+	}
+	testPodSecurityPolicyRunAsUserInterface = []interface{}{
+		map[string]interface{}{
+			"range": []interface{}{
+				map[string]interface{}{
+					"max": 65535,
+					"min": 1,
+				},
+			},
+			"rule": "MustRunAs",
+		},
+	}
+}
+
+func TestFlattenPodSecurityPolicyRunAsUser(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.RunAsUserStrategyOptions
+		ExpectedOutput []interface{}
+	}{
+		{
+			testPodSecurityPolicyRunAsUserConf,
+			testPodSecurityPolicyRunAsUserInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenPodSecurityPolicyRunAsUser(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandPodSecurityPolicyRunAsUser(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.RunAsUserStrategyOptions
+	}{
+		{
+			testPodSecurityPolicyRunAsUserInterface,
+			testPodSecurityPolicyRunAsUserConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandPodSecurityPolicyRunAsUser(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenPodSecurityPolicySELinuxOptions(in *managementClient.SELinuxOptions) []interface{} {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if len(in.Level) > 0 {
+		obj["level"] = in.Level
+	}
+
+	if len(in.Role) > 0 {
+		obj["role"] = in.Role
+	}
+
+	if len(in.Type) > 0 {
+		obj["type"] = in.Type
+	}
+
+	if len(in.User) > 0 {
+		obj["user"] = in.User
+	}
+
+	return []interface{}{obj}
+}
+
+func flattenPodSecurityPolicySELinux(in *managementClient.SELinuxStrategyOptions) []interface{} {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if len(in.Rule) > 0 {
+		obj["rule"] = in.Rule
+	}
+
+	if in.SELinuxOptions != nil {
+		obj["se_linux_option"] = flattenPodSecurityPolicySELinuxOptions(in.SELinuxOptions)
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandPodSecurityPolicySELinuxOptions(p []interface{}) *managementClient.SELinuxOptions {
+	obj := &managementClient.SELinuxOptions{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["level"].(string); ok && len(v) > 0 {
+		obj.Level = v
+	}
+
+	if v, ok := in["role"].(string); ok && len(v) > 0 {
+		obj.Role = v
+	}
+
+	if v, ok := in["type"].(string); ok && len(v) > 0 {
+		obj.Type = v
+	}
+
+	if v, ok := in["user"].(string); ok && len(v) > 0 {
+		obj.User = v
+	}
+
+	return obj
+}
+
+func expandPodSecurityPolicySELinux(p []interface{}) *managementClient.SELinuxStrategyOptions {
+	obj := &managementClient.SELinuxStrategyOptions{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["rule"].(string); ok && len(v) > 0 {
+		obj.Rule = v
+	}
+
+	if v, ok := in["se_linux_option"].([]interface{}); ok && len(v) > 0 {
+		obj.SELinuxOptions = expandPodSecurityPolicySELinuxOptions(v)
+	}
+
+	return obj
+}
@@ -0,0 +1,80 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testPodSecurityPolicySELinuxConf      *managementClient.SELinuxStrategyOptions
+	testPodSecurityPolicySELinuxInterface []interface{}
+)
+
+func init() {
+	testPodSecurityPolicySELinuxConf = &managementClient.SELinuxStrategyOptions{
+		Rule: "MustRunAs",
+		SELinuxOptions: &managementClient.SELinuxOptions{
+			Level: "s0:c123,c456",
+			Role:  "object_r",
+			Type:  "svirt_sandbox_file_t",
+			User:  "system_u",
+		},
+	}
+	testPodSecurityPolicySELinuxInterface = []interface{}{
+		map[string]interface{}{
+			"rule": "MustRunAs",
+			"se_linux_option": []interface{}{
+				map[string]interface{}{
+					"level": "s0:c123,c456",
+					"role":  "object_r",
+					"type":  "svirt_sandbox_file_t",
+					"user":  "system_u",
+				},
+			},
+		},
+	}
+}
+
+func TestFlattenPodSecurityPolicySELinux(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.SELinuxStrategyOptions
+		ExpectedOutput []interface{}
+	}{
+		{
+			testPodSecurityPolicySELinuxConf,
+			testPodSecurityPolicySELinuxInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenPodSecurityPolicySELinux(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandPodSecurityPolicySELinux(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.SELinuxStrategyOptions
+	}{
+		{
+			testPodSecurityPolicySELinuxInterface,
+			testPodSecurityPolicySELinuxConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandPodSecurityPolicySELinux(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
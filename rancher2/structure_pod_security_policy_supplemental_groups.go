@@ -0,0 +1,44 @@
+package rancher2
+
+import (
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenPodSecurityPolicySupplementalGroups(in *managementClient.SupplementalGroupsStrategyOptions) []interface{} {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if len(in.Ranges) > 0 {
+		obj["range"] = flattenPodSecurityPolicyIDRanges(in.Ranges)
+	}
+
+	if len(in.Rule) > 0 {
+		obj["rule"] = in.Rule
+	}
+
+	return []interface{}{obj}
+}
+
+// Expanders
+
+func expandPodSecurityPolicySupplementalGroups(p []interface{}) *managementClient.SupplementalGroupsStrategyOptions {
+	obj := &managementClient.SupplementalGroupsStrategyOptions{}
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["range"].([]interface{}); ok && len(v) > 0 {
+		obj.Ranges = expandPodSecurityPolicyIDRanges(v)
+	}
+
+	if v, ok := in["rule"].(string); ok && len(v) > 0 {
+		obj.Rule = v
+	}
+
+	return obj
+}
@@ -0,0 +1,78 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testPodSecurityPolicySupplementalGroupsConf      *managementClient.SupplementalGroupsStrategyOptions
+	testPodSecurityPolicySupplementalGroupsInterface []interface{}
+)
+
+func init() {
+	testPodSecurityPolicySupplementalGroupsConf = &managementClient.SupplementalGroupsStrategyOptions{
+		Ranges: []managementClient.IDRange{
+			managementClient.IDRange{
+				Max: 65535,
+				Min: 1,
+			},
+		},
+		Rule: "MayRunAs",
+	}
+	testPodSecurityPolicySupplementalGroupsInterface = []interface{}{
+		map[string]interface{}{
+			"range": []interface{}{
+				map[string]interface{}{
+					"max": 65535,
+					"min": 1,
+				},
+			},
+			"rule": "MayRunAs",
+		},
+	}
+}
+
+func TestFlattenPodSecurityPolicySupplementalGroups(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.SupplementalGroupsStrategyOptions
+		ExpectedOutput []interface{}
+	}{
+		{
+			testPodSecurityPolicySupplementalGroupsConf,
+			testPodSecurityPolicySupplementalGroupsInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenPodSecurityPolicySupplementalGroups(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandPodSecurityPolicySupplementalGroups(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.SupplementalGroupsStrategyOptions
+	}{
+		{
+			testPodSecurityPolicySupplementalGroupsInterface,
+			testPodSecurityPolicySupplementalGroupsConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandPodSecurityPolicySupplementalGroups(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenPodSecurityPolicyTemplate(d *schema.ResourceData, in *managementClient.PodSecurityPolicyTemplate) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("name", in.Name)
+	d.Set("description", in.Description)
+
+	if in.AllowPrivilegeEscalation != nil {
+		d.Set("allow_privilege_escalation", *in.AllowPrivilegeEscalation)
+	}
+
+	if in.DefaultAllowPrivilegeEscalation != nil {
+		d.Set("default_allow_privilege_escalation", *in.DefaultAllowPrivilegeEscalation)
+	}
+
+	d.Set("host_ipc", in.HostIPC)
+	d.Set("host_network", in.HostNetwork)
+	d.Set("host_pid", in.HostPID)
+	d.Set("privileged", in.Privileged)
+	d.Set("read_only_root_filesystem", in.ReadOnlyRootFilesystem)
+
+	err := d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("allowed_capabilities", toArrayInterface(in.AllowedCapabilities))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("allowed_proc_mount_types", toArrayInterface(in.AllowedProcMountTypes))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("allowed_unsafe_sysctls", toArrayInterface(in.AllowedUnsafeSysctls))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("default_add_capabilities", toArrayInterface(in.DefaultAddCapabilities))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("forbidden_sysctls", toArrayInterface(in.ForbiddenSysctls))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("required_drop_capabilities", toArrayInterface(in.RequiredDropCapabilities))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("volumes", toArrayInterface(in.Volumes))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("allowed_flex_volume", flattenPodSecurityPolicyAllowedFlexVolumes(in.AllowedFlexVolumes))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("allowed_host_path", flattenPodSecurityPolicyAllowedHostPaths(in.AllowedHostPaths))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("host_port", flattenPodSecurityPolicyHostPortRanges(in.HostPorts))
+	if err != nil {
+		return err
+	}
+
+	if in.FSGroup != nil {
+		err = d.Set("fs_group", flattenPodSecurityPolicyFSGroup(in.FSGroup))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.RunAsUser != nil {
+		err = d.Set("run_as_user", flattenPodSecurityPolicyRunAsUser(in.RunAsUser))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.SELinux != nil {
+		err = d.Set("se_linux", flattenPodSecurityPolicySELinux(in.SELinux))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.SupplementalGroups != nil {
+		err = d.Set("supplemental_group", flattenPodSecurityPolicySupplementalGroups(in.SupplementalGroups))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Expanders
+
+func expandPodSecurityPolicyTemplate(in *schema.ResourceData) *managementClient.PodSecurityPolicyTemplate {
+	obj := &managementClient.PodSecurityPolicyTemplate{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.Name = in.Get("name").(string)
+	obj.Description = in.Get("description").(string)
+
+	if v, ok := in.GetOkExists("allow_privilege_escalation"); ok {
+		allowPrivilegeEscalation := v.(bool)
+		obj.AllowPrivilegeEscalation = &allowPrivilegeEscalation
+	}
+
+	if v, ok := in.GetOkExists("default_allow_privilege_escalation"); ok {
+		defaultAllowPrivilegeEscalation := v.(bool)
+		obj.DefaultAllowPrivilegeEscalation = &defaultAllowPrivilegeEscalation
+	}
+
+	obj.HostIPC = in.Get("host_ipc").(bool)
+	obj.HostNetwork = in.Get("host_network").(bool)
+	obj.HostPID = in.Get("host_pid").(bool)
+	obj.Privileged = in.Get("privileged").(bool)
+	obj.ReadOnlyRootFilesystem = in.Get("read_only_root_filesystem").(bool)
+
+	if v, ok := in.Get("allowed_capabilities").([]interface{}); ok && len(v) > 0 {
+		obj.AllowedCapabilities = toArrayString(v)
+	}
+
+	if v, ok := in.Get("allowed_proc_mount_types").([]interface{}); ok && len(v) > 0 {
+		obj.AllowedProcMountTypes = toArrayString(v)
+	}
+
+	if v, ok := in.Get("allowed_unsafe_sysctls").([]interface{}); ok && len(v) > 0 {
+		obj.AllowedUnsafeSysctls = toArrayString(v)
+	}
+
+	if v, ok := in.Get("default_add_capabilities").([]interface{}); ok && len(v) > 0 {
+		obj.DefaultAddCapabilities = toArrayString(v)
+	}
+
+	if v, ok := in.Get("forbidden_sysctls").([]interface{}); ok && len(v) > 0 {
+		obj.ForbiddenSysctls = toArrayString(v)
+	}
+
+	if v, ok := in.Get("required_drop_capabilities").([]interface{}); ok && len(v) > 0 {
+		obj.RequiredDropCapabilities = toArrayString(v)
+	}
+
+	if v, ok := in.Get("volumes").([]interface{}); ok && len(v) > 0 {
+		obj.Volumes = toArrayString(v)
+	}
+
+	if v, ok := in.Get("allowed_flex_volume").([]interface{}); ok && len(v) > 0 {
+		obj.AllowedFlexVolumes = expandPodSecurityPolicyAllowedFlexVolumes(v)
+	}
+
+	if v, ok := in.Get("allowed_host_path").([]interface{}); ok && len(v) > 0 {
+		obj.AllowedHostPaths = expandPodSecurityPolicyAllowedHostPaths(v)
+	}
+
+	if v, ok := in.Get("host_port").([]interface{}); ok && len(v) > 0 {
+		obj.HostPorts = expandPodSecurityPolicyHostPortRanges(v)
+	}
+
+	if v, ok := in.Get("fs_group").([]interface{}); ok && len(v) > 0 {
+		obj.FSGroup = expandPodSecurityPolicyFSGroup(v)
+	}
+
+	if v, ok := in.Get("run_as_user").([]interface{}); ok && len(v) > 0 {
+		obj.RunAsUser = expandPodSecurityPolicyRunAsUser(v)
+	}
+
+	if v, ok := in.Get("se_linux").([]interface{}); ok && len(v) > 0 {
+		obj.SELinux = expandPodSecurityPolicySELinux(v)
+	}
+
+	if v, ok := in.Get("supplemental_group").([]interface{}); ok && len(v) > 0 {
+		obj.SupplementalGroups = expandPodSecurityPolicySupplementalGroups(v)
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
@@ -0,0 +1,120 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testPodSecurityPolicyTemplateConf      *managementClient.PodSecurityPolicyTemplate
+	testPodSecurityPolicyTemplateInterface map[string]interface{}
+)
+
+func init() {
+	allowPrivilegeEscalation := false
+	defaultAllowPrivilegeEscalation := false
+	testPodSecurityPolicyTemplateConf = &managementClient.PodSecurityPolicyTemplate{
+		Name:                            "test",
+		Description:                     "description",
+		AllowPrivilegeEscalation:        &allowPrivilegeEscalation,
+		AllowedCapabilities:             []string{"NET_BIND_SERVICE"},
+		AllowedFlexVolumes:              testPodSecurityPolicyAllowedFlexVolumesConf,
+		AllowedHostPaths:                testPodSecurityPolicyAllowedHostPathsConf,
+		AllowedProcMountTypes:           []string{"Default"},
+		AllowedUnsafeSysctls:            []string{"kernel.msg*"},
+		DefaultAddCapabilities:          []string{"CHOWN"},
+		DefaultAllowPrivilegeEscalation: &defaultAllowPrivilegeEscalation,
+		ForbiddenSysctls:                []string{"kernel.shm_rmid_forced"},
+		FSGroup:                         testPodSecurityPolicyFSGroupConf,
+		HostIPC:                         false,
+		HostNetwork:                     false,
+		HostPID:                         false,
+		HostPorts:                       testPodSecurityPolicyHostPortRangesConf,
+		Privileged:                      false,
+		ReadOnlyRootFilesystem:          true,
+		RequiredDropCapabilities:        []string{"ALL"},
+		RunAsUser:                       testPodSecurityPolicyRunAsUserConf,
+		SELinux:                         testPodSecurityPolicySELinuxConf,
+		SupplementalGroups:              testPodSecurityPolicySupplementalGroupsConf,
+		Volumes:                         []string{"configMap", "emptyDir", "secret"},
+	}
+	testPodSecurityPolicyTemplateInterface = map[string]interface{}{
+		"name":                               "test",
+		"description":                        "description",
+		"allow_privilege_escalation":         false,
+		"allowed_capabilities":               []interface{}{"NET_BIND_SERVICE"},
+		"allowed_flex_volume":                testPodSecurityPolicyAllowedFlexVolumesInterface,
+		"allowed_host_path":                  testPodSecurityPolicyAllowedHostPathsInterface,
+		"allowed_proc_mount_types":           []interface{}{"Default"},
+		"allowed_unsafe_sysctls":             []interface{}{"kernel.msg*"},
+		"default_add_capabilities":           []interface{}{"CHOWN"},
+		"default_allow_privilege_escalation": false,
+		"forbidden_sysctls":                  []interface{}{"kernel.shm_rmid_forced"},
+		"fs_group":                           testPodSecurityPolicyFSGroupInterface,
+		"host_ipc":                           false,
+		"host_network":                       false,
+		"host_pid":                           false,
+		"host_port":                          testPodSecurityPolicyHostPortRangesInterface,
+		"privileged":                         false,
+		"read_only_root_filesystem":          true,
+		"required_drop_capabilities":         []interface{}{"ALL"},
+		"run_as_user":                        testPodSecurityPolicyRunAsUserInterface,
+		"se_linux":                           testPodSecurityPolicySELinuxInterface,
+		"supplemental_group":                 testPodSecurityPolicySupplementalGroupsInterface,
+		"volumes":                            []interface{}{"configMap", "emptyDir", "secret"},
+	}
+}
+
+func TestFlattenPodSecurityPolicyTemplate(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.PodSecurityPolicyTemplate
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testPodSecurityPolicyTemplateConf,
+			testPodSecurityPolicyTemplateInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, podSecurityPolicyTemplateFields(), map[string]interface{}{})
+		err := flattenPodSecurityPolicyTemplate(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandPodSecurityPolicyTemplate(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *managementClient.PodSecurityPolicyTemplate
+	}{
+		{
+			testPodSecurityPolicyTemplateInterface,
+			testPodSecurityPolicyTemplateConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, podSecurityPolicyTemplateFields(), tc.Input)
+		output := expandPodSecurityPolicyTemplate(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
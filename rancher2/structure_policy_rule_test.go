@@ -0,0 +1,82 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testPolicyRulesConf      []managementClient.PolicyRule
+	testPolicyRulesInterface []interface{}
+)
+
+func init() {
+	testPolicyRulesConf = []managementClient.PolicyRule{
+		{
+			APIGroups:     []string{"group1", "group2"},
+			ResourceNames: []string{"name1", "name2"},
+			Resources:     []string{"resource1", "resource2"},
+			Verbs:         []string{"get", "list"},
+		},
+		{
+			NonResourceURLs: []string{"/healthz"},
+			Verbs:           []string{"get"},
+		},
+	}
+	testPolicyRulesInterface = []interface{}{
+		map[string]interface{}{
+			"api_groups":     []interface{}{"group1", "group2"},
+			"resource_names": []interface{}{"name1", "name2"},
+			"resources":      []interface{}{"resource1", "resource2"},
+			"verbs":          []interface{}{"get", "list"},
+		},
+		map[string]interface{}{
+			"non_resource_urls": []interface{}{"/healthz"},
+			"verbs":             []interface{}{"get"},
+		},
+	}
+}
+
+func TestFlattenPolicyRules(t *testing.T) {
+
+	cases := []struct {
+		Input          []managementClient.PolicyRule
+		ExpectedOutput []interface{}
+	}{
+		{
+			testPolicyRulesConf,
+			testPolicyRulesInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenPolicyRules(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandPolicyRules(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput []managementClient.PolicyRule
+	}{
+		{
+			testPolicyRulesInterface,
+			testPolicyRulesConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandPolicyRules(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
@@ -7,6 +7,35 @@ import (
 
 // Flatteners
 
+func flattenProjectContainerResourceLimit(in *managementClient.ContainerResourceLimit) []interface{} {
+	obj := make(map[string]interface{})
+	if in == nil {
+		return []interface{}{}
+	}
+
+	if len(in.LimitsCPU) > 0 {
+		obj["limits_cpu"] = in.LimitsCPU
+	}
+
+	if len(in.LimitsMemory) > 0 {
+		obj["limits_memory"] = in.LimitsMemory
+	}
+
+	if len(in.RequestsCPU) > 0 {
+		obj["requests_cpu"] = in.RequestsCPU
+	}
+
+	if len(in.RequestsMemory) > 0 {
+		obj["requests_memory"] = in.RequestsMemory
+	}
+
+	if len(obj) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{obj}
+}
+
 func flattenProjectResourceQuotaLimit(in *managementClient.ResourceQuotaLimit) []interface{} {
 	obj := make(map[string]interface{})
 	if in == nil {
@@ -96,6 +125,12 @@ func flattenProject(d *schema.ResourceData, in *managementClient.Project) error
 	d.Set("cluster_id", in.ClusterID)
 	d.Set("name", in.Name)
 	d.Set("description", in.Description)
+	d.Set("pod_security_policy_template_id", in.PodSecurityPolicyTemplateName)
+
+	err := d.Set("container_resource_limit", flattenProjectContainerResourceLimit(in.ContainerDefaultResourceLimit))
+	if err != nil {
+		return err
+	}
 
 	if in.ResourceQuota != nil && in.NamespaceDefaultResourceQuota != nil {
 		resourceQuota := flattenProjectResourceQuota(in.ResourceQuota, in.NamespaceDefaultResourceQuota)
@@ -105,7 +140,7 @@ func flattenProject(d *schema.ResourceData, in *managementClient.Project) error
 		}
 	}
 
-	err := d.Set("annotations", toMapInterface(in.Annotations))
+	err = d.Set("annotations", toMapInterface(in.Annotations))
 	if err != nil {
 		return err
 	}
@@ -121,6 +156,33 @@ func flattenProject(d *schema.ResourceData, in *managementClient.Project) error
 
 // Expanders
 
+func expandProjectContainerResourceLimit(p []interface{}) *managementClient.ContainerResourceLimit {
+	obj := &managementClient.ContainerResourceLimit{}
+
+	if len(p) == 0 || p[0] == nil {
+		return obj
+	}
+	in := p[0].(map[string]interface{})
+
+	if v, ok := in["limits_cpu"].(string); ok && len(v) > 0 {
+		obj.LimitsCPU = v
+	}
+
+	if v, ok := in["limits_memory"].(string); ok && len(v) > 0 {
+		obj.LimitsMemory = v
+	}
+
+	if v, ok := in["requests_cpu"].(string); ok && len(v) > 0 {
+		obj.RequestsCPU = v
+	}
+
+	if v, ok := in["requests_memory"].(string); ok && len(v) > 0 {
+		obj.RequestsMemory = v
+	}
+
+	return obj
+}
+
 func expandProjectResourceQuotaLimit(p []interface{}) *managementClient.ResourceQuotaLimit {
 	obj := &managementClient.ResourceQuotaLimit{}
 
@@ -220,6 +282,10 @@ func expandProject(in *schema.ResourceData) *managementClient.Project {
 	obj.Name = in.Get("name").(string)
 	obj.Description = in.Get("description").(string)
 
+	if v, ok := in.Get("container_resource_limit").([]interface{}); ok && len(v) > 0 {
+		obj.ContainerDefaultResourceLimit = expandProjectContainerResourceLimit(v)
+	}
+
 	if v, ok := in.Get("resource_quota").([]interface{}); ok && len(v) > 0 {
 		resourceQuota, nsResourceQuota := expandProjectResourceQuota(v)
 		obj.ResourceQuota = resourceQuota
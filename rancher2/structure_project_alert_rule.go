@@ -0,0 +1,118 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenProjectAlertRule(d *schema.ResourceData, in *managementClient.ProjectAlertRule) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("project_id", in.ProjectID)
+	d.Set("group_id", in.GroupID)
+	d.Set("name", in.Name)
+	d.Set("group_interval_seconds", int(in.GroupIntervalSeconds))
+	d.Set("group_wait_seconds", int(in.GroupWaitSeconds))
+	d.Set("repeat_interval_seconds", int(in.RepeatIntervalSeconds))
+	d.Set("severity", in.Severity)
+
+	if in.Inherited != nil {
+		d.Set("inherited", *in.Inherited)
+	}
+
+	// Inactive rules can't be muted, keeping muted argument on state
+	switch in.AlertState {
+	case alertRuleStateInactive:
+		d.Set("enabled", false)
+	case alertRuleStateMuted:
+		d.Set("enabled", true)
+		d.Set("muted", true)
+	default:
+		d.Set("enabled", true)
+		d.Set("muted", false)
+	}
+
+	if in.MetricRule != nil {
+		err := d.Set("metric_rule", flattenAlertMetricRule(in.MetricRule))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.PodRule != nil {
+		err := d.Set("pod_rule", flattenAlertPodRule(in.PodRule))
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.WorkloadRule != nil {
+		err := d.Set("workload_rule", flattenAlertWorkloadRule(in.WorkloadRule))
+		if err != nil {
+			return err
+		}
+	}
+
+	err := d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Expanders
+
+func expandProjectAlertRule(in *schema.ResourceData) *managementClient.ProjectAlertRule {
+	obj := &managementClient.ProjectAlertRule{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.ProjectID = in.Get("project_id").(string)
+	obj.GroupID = in.Get("group_id").(string)
+	obj.Name = in.Get("name").(string)
+	obj.GroupIntervalSeconds = int64(in.Get("group_interval_seconds").(int))
+	obj.GroupWaitSeconds = int64(in.Get("group_wait_seconds").(int))
+	obj.RepeatIntervalSeconds = int64(in.Get("repeat_interval_seconds").(int))
+	obj.Severity = in.Get("severity").(string)
+
+	inherited := in.Get("inherited").(bool)
+	obj.Inherited = &inherited
+
+	if v, ok := in.Get("metric_rule").([]interface{}); ok && len(v) > 0 {
+		obj.MetricRule = expandAlertMetricRule(v)
+	}
+
+	if v, ok := in.Get("pod_rule").([]interface{}); ok && len(v) > 0 {
+		obj.PodRule = expandAlertPodRule(v)
+	}
+
+	if v, ok := in.Get("workload_rule").([]interface{}); ok && len(v) > 0 {
+		obj.WorkloadRule = expandAlertWorkloadRule(v)
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
@@ -0,0 +1,105 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testProjectAlertRuleConf      *managementClient.ProjectAlertRule
+	testProjectAlertRuleInterface map[string]interface{}
+)
+
+func init() {
+	testProjectAlertRuleConf = &managementClient.ProjectAlertRule{
+		ProjectID:             "c-test:p-test",
+		GroupID:               "p-test:g-test",
+		Name:                  "test",
+		GroupIntervalSeconds:  300,
+		GroupWaitSeconds:      60,
+		Inherited:             newFalse(),
+		RepeatIntervalSeconds: 7200,
+		Severity:              alertRuleSeverityWarning,
+		WorkloadRule: &managementClient.WorkloadRule{
+			AvailablePercentage: 50,
+			Selector: map[string]string{
+				"app": "test",
+			},
+		},
+	}
+	testProjectAlertRuleInterface = map[string]interface{}{
+		"project_id":              "c-test:p-test",
+		"group_id":                "p-test:g-test",
+		"name":                    "test",
+		"enabled":                 true,
+		"group_interval_seconds":  300,
+		"group_wait_seconds":      60,
+		"inherited":               false,
+		"muted":                   false,
+		"repeat_interval_seconds": 7200,
+		"severity":                alertRuleSeverityWarning,
+		"workload_rule": []interface{}{
+			map[string]interface{}{
+				"available_percentage": 50,
+				"selector": map[string]interface{}{
+					"app": "test",
+				},
+				"workload_id": "",
+			},
+		},
+	}
+}
+
+func TestFlattenProjectAlertRule(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.ProjectAlertRule
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testProjectAlertRuleConf,
+			testProjectAlertRuleInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, projectAlertRuleFields(), map[string]interface{}{})
+		err := flattenProjectAlertRule(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandProjectAlertRule(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *managementClient.ProjectAlertRule
+	}{
+		{
+			testProjectAlertRuleInterface,
+			testProjectAlertRuleConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, projectAlertRuleFields(), tc.Input)
+		output := expandProjectAlertRule(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
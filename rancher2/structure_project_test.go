@@ -9,6 +9,8 @@ import (
 )
 
 var (
+	testProjectContainerResourceLimitConf           *managementClient.ContainerResourceLimit
+	testProjectContainerResourceLimitInterface      []interface{}
 	testProjectResourceQuotaLimitConf               *managementClient.ResourceQuotaLimit
 	testProjectResourceQuotaLimitInterface          []interface{}
 	testProjectResourceQuotaLimitNamespaceConf      *managementClient.ResourceQuotaLimit
@@ -21,6 +23,20 @@ var (
 )
 
 func init() {
+	testProjectContainerResourceLimitConf = &managementClient.ContainerResourceLimit{
+		LimitsCPU:      "cpu",
+		LimitsMemory:   "memory",
+		RequestsCPU:    "r_cpu",
+		RequestsMemory: "r_memory",
+	}
+	testProjectContainerResourceLimitInterface = []interface{}{
+		map[string]interface{}{
+			"limits_cpu":      "cpu",
+			"limits_memory":   "memory",
+			"requests_cpu":    "r_cpu",
+			"requests_memory": "r_memory",
+		},
+	}
 	testProjectResourceQuotaLimitConf = &managementClient.ResourceQuotaLimit{
 		ConfigMaps:             "config",
 		LimitsCPU:              "cpu",
@@ -101,14 +117,41 @@ func init() {
 		ClusterID:                     "cluster-test",
 		Name:                          "test",
 		Description:                   "description",
+		ContainerDefaultResourceLimit: testProjectContainerResourceLimitConf,
 		ResourceQuota:                 testProjectResourceQuotaConf,
 		NamespaceDefaultResourceQuota: testProjectNamespaceResourceQuotaConf,
 	}
 	testProjectInterface = map[string]interface{}{
-		"cluster_id":     "cluster-test",
-		"name":           "test",
-		"description":    "description",
-		"resource_quota": testProjectResourceQuotaInterface,
+		"cluster_id":               "cluster-test",
+		"name":                     "test",
+		"description":              "description",
+		"container_resource_limit": testProjectContainerResourceLimitInterface,
+		"resource_quota":           testProjectResourceQuotaInterface,
+	}
+}
+
+func TestFlattenProjectContainerResourceLimit(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.ContainerResourceLimit
+		ExpectedOutput []interface{}
+	}{
+		{
+			testProjectContainerResourceLimitConf,
+			testProjectContainerResourceLimitInterface,
+		},
+		{
+			&managementClient.ContainerResourceLimit{},
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenProjectContainerResourceLimit(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
 	}
 }
 
@@ -185,6 +228,27 @@ func TestFlattenProject(t *testing.T) {
 	}
 }
 
+func TestExpandProjectContainerResourceLimit(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *managementClient.ContainerResourceLimit
+	}{
+		{
+			testProjectContainerResourceLimitInterface,
+			testProjectContainerResourceLimitConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandProjectContainerResourceLimit(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
 func TestExpandProjectResourceQuotaLimit(t *testing.T) {
 
 	cases := []struct {
@@ -0,0 +1,86 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+// Flatteners
+
+func flattenRegistry(d *schema.ResourceData, in *projectClient.DockerCredential) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("name", in.Name)
+	d.Set("description", in.Description)
+
+	if len(in.ProjectID) > 0 {
+		d.Set("project_id", in.ProjectID)
+	}
+
+	if len(in.NamespaceId) > 0 {
+		d.Set("namespace_id", in.NamespaceId)
+	}
+
+	// Registries are only updated on state if their checksum has changed since last apply, forcing a diff
+	if checksum := registryCredentialsChecksum(in.Registries); checksum != d.Get("registries_checksum").(string) {
+		v, ok := d.Get("registries").([]interface{})
+		if !ok {
+			v = []interface{}{}
+		}
+		err := d.Set("registries", flattenRegistryCredentials(in.Registries, v))
+		if err != nil {
+			return err
+		}
+		d.Set("registries_checksum", checksum)
+	}
+
+	err := d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Expanders
+
+func expandRegistry(in *schema.ResourceData) *projectClient.DockerCredential {
+	obj := &projectClient.DockerCredential{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.ProjectID = in.Get("project_id").(string)
+	obj.Name = in.Get("name").(string)
+	obj.Description = in.Get("description").(string)
+
+	if v, ok := in.Get("namespace_id").(string); ok && len(v) > 0 {
+		obj.NamespaceId = v
+	}
+
+	if v, ok := in.Get("registries").([]interface{}); ok && len(v) > 0 {
+		obj.Registries = expandRegistryCredentials(v)
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
@@ -0,0 +1,107 @@
+package rancher2
+
+import (
+	"sort"
+
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+// Flatteners
+
+func flattenRegistryCredentials(in map[string]projectClient.RegistryCredential, p []interface{}) []interface{} {
+	if len(in) == 0 {
+		return []interface{}{}
+	}
+
+	// Keeping configured order and passwords, rancher doesn't return them
+	addresses := []string{}
+	passwords := map[string]string{}
+	for i := range p {
+		obj, ok := p[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		address, _ := obj["address"].(string)
+		if _, ok := in[address]; !ok {
+			continue
+		}
+		if _, ok := passwords[address]; ok {
+			continue
+		}
+		addresses = append(addresses, address)
+		passwords[address], _ = obj["password"].(string)
+	}
+	remaining := []string{}
+	for address := range in {
+		if _, ok := passwords[address]; !ok {
+			remaining = append(remaining, address)
+		}
+	}
+	sort.Strings(remaining)
+	addresses = append(addresses, remaining...)
+
+	out := make([]interface{}, len(addresses))
+	for i, address := range addresses {
+		obj := make(map[string]interface{})
+		obj["address"] = address
+
+		if len(in[address].Username) > 0 {
+			obj["username"] = in[address].Username
+		}
+
+		// Password is kept from state if rancher doesn't return it
+		password := passwords[address]
+		if v := in[address].Password; len(v) > 0 {
+			password = v
+		}
+		if len(password) > 0 {
+			obj["password"] = password
+		}
+
+		out[i] = obj
+	}
+
+	return out
+}
+
+// Expanders
+
+func expandRegistryCredentials(p []interface{}) map[string]projectClient.RegistryCredential {
+	out := map[string]projectClient.RegistryCredential{}
+	if len(p) == 0 || p[0] == nil {
+		return out
+	}
+
+	for i := range p {
+		in := p[i].(map[string]interface{})
+		obj := projectClient.RegistryCredential{}
+
+		if v, ok := in["password"].(string); ok && len(v) > 0 {
+			obj.Password = v
+		}
+
+		if v, ok := in["username"].(string); ok && len(v) > 0 {
+			obj.Username = v
+		}
+
+		out[in["address"].(string)] = obj
+	}
+
+	return out
+}
+
+// registryCredentialsChecksum returns a checksum of registry credentials, sorted by address
+func registryCredentialsChecksum(in map[string]projectClient.RegistryCredential) string {
+	addresses := make([]string, 0, len(in))
+	for address := range in {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	sum := ""
+	for _, address := range addresses {
+		sum = GetSHA256Checksum(sum + address + in[address].Username + GetSHA256Checksum(in[address].Password))
+	}
+
+	return sum
+}
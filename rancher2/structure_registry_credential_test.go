@@ -0,0 +1,80 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+var (
+	testRegistryCredentialsConf      map[string]projectClient.RegistryCredential
+	testRegistryCredentialsInterface []interface{}
+)
+
+func init() {
+	testRegistryCredentialsConf = map[string]projectClient.RegistryCredential{
+		"registry1.test.io": projectClient.RegistryCredential{
+			Password: "pass1",
+			Username: "user1",
+		},
+		"registry2.test.io": projectClient.RegistryCredential{
+			Password: "pass2",
+			Username: "user2",
+		},
+	}
+	testRegistryCredentialsInterface = []interface{}{
+		map[string]interface{}{
+			"address":  "registry2.test.io",
+			"password": "pass2",
+			"username": "user2",
+		},
+		map[string]interface{}{
+			"address":  "registry1.test.io",
+			"password": "pass1",
+			"username": "user1",
+		},
+	}
+}
+
+func TestFlattenRegistryCredentials(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]projectClient.RegistryCredential
+		ExpectedOutput []interface{}
+	}{
+		{
+			testRegistryCredentialsConf,
+			testRegistryCredentialsInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenRegistryCredentials(tc.Input, tc.ExpectedOutput)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestExpandRegistryCredentials(t *testing.T) {
+
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput map[string]projectClient.RegistryCredential
+	}{
+		{
+			testRegistryCredentialsInterface,
+			testRegistryCredentialsConf,
+		},
+	}
+
+	for _, tc := range cases {
+		output := expandRegistryCredentials(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
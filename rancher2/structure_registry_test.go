@@ -0,0 +1,104 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+var (
+	testRegistryConf                *projectClient.DockerCredential
+	testRegistryInterface           map[string]interface{}
+	testRegistryNamespacedConf      *projectClient.DockerCredential
+	testRegistryNamespacedInterface map[string]interface{}
+)
+
+func init() {
+	testRegistryConf = &projectClient.DockerCredential{
+		ProjectID:   "project:test",
+		Name:        "test",
+		Description: "description",
+		Registries:  testRegistryCredentialsConf,
+	}
+	testRegistryInterface = map[string]interface{}{
+		"project_id":  "project:test",
+		"name":        "test",
+		"description": "description",
+		"registries":  testRegistryCredentialsInterface,
+	}
+	testRegistryNamespacedConf = &projectClient.DockerCredential{
+		ProjectID:   "project:test",
+		NamespaceId: "namespace_test",
+		Name:        "test",
+		Description: "description",
+		Registries:  testRegistryCredentialsConf,
+	}
+	testRegistryNamespacedInterface = map[string]interface{}{
+		"project_id":   "project:test",
+		"namespace_id": "namespace_test",
+		"name":         "test",
+		"description":  "description",
+		"registries":   testRegistryCredentialsInterface,
+	}
+}
+
+func TestFlattenRegistry(t *testing.T) {
+
+	cases := []struct {
+		Input          *projectClient.DockerCredential
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testRegistryConf,
+			testRegistryInterface,
+		},
+		{
+			testRegistryNamespacedConf,
+			testRegistryNamespacedInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, registryFields(), tc.ExpectedOutput)
+		err := flattenRegistry(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandRegistry(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *projectClient.DockerCredential
+	}{
+		{
+			testRegistryInterface,
+			testRegistryConf,
+		},
+		{
+			testRegistryNamespacedInterface,
+			testRegistryNamespacedConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, registryFields(), tc.Input)
+		output := expandRegistry(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
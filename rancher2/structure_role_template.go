@@ -0,0 +1,100 @@
+package rancher2
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenRoleTemplate(d *schema.ResourceData, in *managementClient.RoleTemplate) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("name", in.Name)
+	d.Set("context", in.Context)
+	d.Set("description", in.Description)
+	d.Set("administrative", in.Administrative)
+	d.Set("builtin", in.Builtin)
+	d.Set("external", in.External)
+	d.Set("hidden", in.Hidden)
+	d.Set("locked", in.Locked)
+
+	switch in.Context {
+	case roleTemplateContextCluster:
+		d.Set("default_role", in.ClusterCreatorDefault)
+	case roleTemplateContextProject:
+		d.Set("default_role", in.ProjectCreatorDefault)
+	}
+
+	err := d.Set("role_template_ids", toArrayInterface(in.RoleTemplateIDs))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("rules", flattenPolicyRules(in.Rules))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// Expanders
+
+func expandRoleTemplate(in *schema.ResourceData) *managementClient.RoleTemplate {
+	obj := &managementClient.RoleTemplate{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.Name = in.Get("name").(string)
+	obj.Context = in.Get("context").(string)
+	obj.Description = in.Get("description").(string)
+	obj.Administrative = in.Get("administrative").(bool)
+	obj.External = in.Get("external").(bool)
+	obj.Hidden = in.Get("hidden").(bool)
+	obj.Locked = in.Get("locked").(bool)
+
+	switch obj.Context {
+	case roleTemplateContextCluster:
+		obj.ClusterCreatorDefault = in.Get("default_role").(bool)
+	case roleTemplateContextProject:
+		obj.ProjectCreatorDefault = in.Get("default_role").(bool)
+	}
+
+	if v, ok := in.Get("role_template_ids").([]interface{}); ok && len(v) > 0 {
+		obj.RoleTemplateIDs = toArrayString(v)
+	}
+
+	if v, ok := in.Get("rules").([]interface{}); ok && len(v) > 0 {
+		obj.Rules = expandPolicyRules(v)
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
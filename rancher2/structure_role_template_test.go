@@ -0,0 +1,128 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testRoleTemplateClusterConf      *managementClient.RoleTemplate
+	testRoleTemplateClusterInterface map[string]interface{}
+	testRoleTemplateProjectConf      *managementClient.RoleTemplate
+	testRoleTemplateProjectInterface map[string]interface{}
+)
+
+func init() {
+	testRoleTemplateClusterConf = &managementClient.RoleTemplate{
+		Name:                  "role-template-test",
+		Context:               roleTemplateContextCluster,
+		Description:           "description",
+		Administrative:        true,
+		ClusterCreatorDefault: true,
+		Locked:                true,
+		RoleTemplateIDs:       []string{"cluster-member"},
+		Rules: []managementClient.PolicyRule{
+			{
+				APIGroups:       []string{"group1", "group2"},
+				NonResourceURLs: []string{"url1", "url2"},
+				ResourceNames:   []string{"name1", "name2"},
+				Resources:       []string{"resource1", "resource2"},
+				Verbs:           []string{"get", "list"},
+			},
+		},
+	}
+	testRoleTemplateClusterInterface = map[string]interface{}{
+		"name":              "role-template-test",
+		"context":           roleTemplateContextCluster,
+		"description":       "description",
+		"administrative":    true,
+		"default_role":      true,
+		"locked":            true,
+		"role_template_ids": []interface{}{"cluster-member"},
+		"rules": []interface{}{
+			map[string]interface{}{
+				"api_groups":        []interface{}{"group1", "group2"},
+				"non_resource_urls": []interface{}{"url1", "url2"},
+				"resource_names":    []interface{}{"name1", "name2"},
+				"resources":         []interface{}{"resource1", "resource2"},
+				"verbs":             []interface{}{"get", "list"},
+			},
+		},
+	}
+	testRoleTemplateProjectConf = &managementClient.RoleTemplate{
+		Name:                  "role-template-test",
+		Context:               roleTemplateContextProject,
+		Description:           "description",
+		ProjectCreatorDefault: true,
+		RoleTemplateIDs:       []string{"project-member", "read-only"},
+	}
+	testRoleTemplateProjectInterface = map[string]interface{}{
+		"name":              "role-template-test",
+		"context":           roleTemplateContextProject,
+		"description":       "description",
+		"default_role":      true,
+		"role_template_ids": []interface{}{"project-member", "read-only"},
+	}
+}
+
+func TestFlattenRoleTemplate(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.RoleTemplate
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testRoleTemplateClusterConf,
+			testRoleTemplateClusterInterface,
+		},
+		{
+			testRoleTemplateProjectConf,
+			testRoleTemplateProjectInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, roleTemplateFields(), map[string]interface{}{})
+		err := flattenRoleTemplate(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandRoleTemplate(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *managementClient.RoleTemplate
+	}{
+		{
+			testRoleTemplateClusterInterface,
+			testRoleTemplateClusterConf,
+		},
+		{
+			testRoleTemplateProjectInterface,
+			testRoleTemplateProjectConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, roleTemplateFields(), tc.Input)
+		output := expandRoleTemplate(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
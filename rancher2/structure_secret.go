@@ -0,0 +1,129 @@
+package rancher2
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+// Flatteners
+
+func flattenSecretData(in map[string]string) (map[string]interface{}, error) {
+	out := make(map[string]interface{})
+
+	for k, v := range in {
+		value, err := Base64Decode(v)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = value
+	}
+
+	return out, nil
+}
+
+func flattenSecret(d *schema.ResourceData, in *projectClient.Secret) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("name", in.Name)
+	d.Set("description", in.Description)
+
+	if len(in.ProjectID) > 0 {
+		d.Set("project_id", in.ProjectID)
+	}
+
+	if len(in.NamespaceId) > 0 {
+		d.Set("namespace_id", in.NamespaceId)
+	}
+
+	// Data is only updated on state if its checksum has changed since last apply, forcing a diff
+	if checksum := secretDataChecksum(in.Data); checksum != d.Get("data_checksum").(string) {
+		data, err := flattenSecretData(in.Data)
+		if err != nil {
+			return err
+		}
+
+		err = d.Set("data", data)
+		if err != nil {
+			return err
+		}
+		d.Set("data_checksum", checksum)
+	}
+
+	err := d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Expanders
+
+func expandSecretData(in map[string]interface{}) map[string]string {
+	out := make(map[string]string)
+
+	for k, v := range in {
+		out[k] = Base64Encode(v.(string))
+	}
+
+	return out
+}
+
+func expandSecret(in *schema.ResourceData) *projectClient.Secret {
+	obj := &projectClient.Secret{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.ProjectID = in.Get("project_id").(string)
+	obj.Name = in.Get("name").(string)
+	obj.Description = in.Get("description").(string)
+
+	if v, ok := in.Get("namespace_id").(string); ok && len(v) > 0 {
+		obj.NamespaceId = v
+	}
+
+	if v, ok := in.Get("data").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Data = expandSecretData(v)
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
+
+// secretDataChecksum returns a checksum of data key/values, sorted by key
+func secretDataChecksum(in map[string]string) string {
+	keys := make([]string, 0, len(in))
+	for k := range in {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	sum := ""
+	for _, k := range keys {
+		sum = GetSHA256Checksum(sum + k + GetSHA256Checksum(in[k]))
+	}
+
+	return sum
+}
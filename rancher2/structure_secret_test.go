@@ -0,0 +1,116 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	projectClient "github.com/rancher/types/client/project/v3"
+)
+
+var (
+	testSecretConf                *projectClient.Secret
+	testSecretInterface           map[string]interface{}
+	testSecretNamespacedConf      *projectClient.Secret
+	testSecretNamespacedInterface map[string]interface{}
+)
+
+func init() {
+	testSecretConf = &projectClient.Secret{
+		ProjectID:   "project:test",
+		Name:        "test",
+		Description: "description",
+		Data: map[string]string{
+			"username": "dXNlcg==",
+			"password": "cGFzcw==",
+		},
+	}
+	testSecretInterface = map[string]interface{}{
+		"project_id":  "project:test",
+		"name":        "test",
+		"description": "description",
+		"data": map[string]interface{}{
+			"username": "user",
+			"password": "pass",
+		},
+	}
+	testSecretNamespacedConf = &projectClient.Secret{
+		ProjectID:   "project:test",
+		NamespaceId: "namespace_test",
+		Name:        "test",
+		Description: "description",
+		Data: map[string]string{
+			"username": "dXNlcg==",
+			"password": "cGFzcw==",
+		},
+	}
+	testSecretNamespacedInterface = map[string]interface{}{
+		"project_id":   "project:test",
+		"namespace_id": "namespace_test",
+		"name":         "test",
+		"description":  "description",
+		"data": map[string]interface{}{
+			"username": "user",
+			"password": "pass",
+		},
+	}
+}
+
+func TestFlattenSecret(t *testing.T) {
+
+	cases := []struct {
+		Input          *projectClient.Secret
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testSecretConf,
+			testSecretInterface,
+		},
+		{
+			testSecretNamespacedConf,
+			testSecretNamespacedInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, secretFields(), map[string]interface{}{})
+		err := flattenSecret(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandSecret(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *projectClient.Secret
+	}{
+		{
+			testSecretInterface,
+			testSecretConf,
+		},
+		{
+			testSecretNamespacedInterface,
+			testSecretNamespacedConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, secretFields(), tc.Input)
+		output := expandSecret(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
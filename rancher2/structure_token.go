@@ -0,0 +1,83 @@
+package rancher2
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+// Flatteners
+
+func flattenToken(d *schema.ResourceData, in *managementClient.Token) error {
+	if in == nil {
+		return nil
+	}
+
+	d.SetId(in.ID)
+	d.Set("cluster_id", in.ClusterID)
+	d.Set("description", in.Description)
+	d.Set("expired", in.Expired)
+	d.Set("name", in.Name)
+	d.Set("user_id", in.UserID)
+
+	if in.TTLMillis > 0 {
+		d.Set("ttl", int(in.TTLMillis/1000))
+	}
+
+	if in.Enabled != nil {
+		d.Set("enabled", *in.Enabled)
+	}
+
+	// Token secret is only returned by rancher on creation
+	if len(in.Token) > 0 {
+		d.Set("token", in.Token)
+		d.Set("access_key", splitTokenID(in.Token))
+		if strings.Contains(in.Token, ":") {
+			d.Set("secret_key", in.Token[strings.Index(in.Token, ":")+1:])
+		}
+	}
+
+	err := d.Set("annotations", toMapInterface(in.Annotations))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("labels", toMapInterface(in.Labels))
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+// Expanders
+
+func expandToken(in *schema.ResourceData) *managementClient.Token {
+	obj := &managementClient.Token{}
+	if in == nil {
+		return nil
+	}
+
+	if v := in.Id(); len(v) > 0 {
+		obj.ID = v
+	}
+
+	obj.ClusterID = in.Get("cluster_id").(string)
+	obj.Description = in.Get("description").(string)
+
+	if v, ok := in.Get("ttl").(int); ok && v > 0 {
+		obj.TTLMillis = int64(v) * 1000
+	}
+
+	if v, ok := in.Get("annotations").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Annotations = toMapString(v)
+	}
+
+	if v, ok := in.Get("labels").(map[string]interface{}); ok && len(v) > 0 {
+		obj.Labels = toMapString(v)
+	}
+
+	return obj
+}
@@ -0,0 +1,114 @@
+package rancher2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+var (
+	testTokenConf              *managementClient.Token
+	testTokenInterface         map[string]interface{}
+	testTokenCreatedConf       *managementClient.Token
+	testTokenCreatedInterface  map[string]interface{}
+	testTokenExpandedConf      *managementClient.Token
+	testTokenExpandedInterface map[string]interface{}
+)
+
+func init() {
+	testTokenConf = &managementClient.Token{
+		ClusterID:   "cluster-test",
+		Description: "description",
+		Enabled:     newTrue(),
+		Expired:     true,
+		Name:        "token-test",
+		TTLMillis:   120000,
+		UserID:      "user-test",
+	}
+	testTokenInterface = map[string]interface{}{
+		"cluster_id":  "cluster-test",
+		"description": "description",
+		"enabled":     true,
+		"expired":     true,
+		"name":        "token-test",
+		"ttl":         120,
+		"user_id":     "user-test",
+	}
+	testTokenCreatedConf = &managementClient.Token{
+		Name:  "token-test",
+		Token: "token-test:secret",
+	}
+	testTokenCreatedInterface = map[string]interface{}{
+		"name":       "token-test",
+		"token":      "token-test:secret",
+		"access_key": "token-test",
+		"secret_key": "secret",
+	}
+	testTokenExpandedConf = &managementClient.Token{
+		ClusterID:   "cluster-test",
+		Description: "description",
+		TTLMillis:   120000,
+	}
+	testTokenExpandedInterface = map[string]interface{}{
+		"cluster_id":  "cluster-test",
+		"description": "description",
+		"ttl":         120,
+	}
+}
+
+func TestFlattenToken(t *testing.T) {
+
+	cases := []struct {
+		Input          *managementClient.Token
+		ExpectedOutput map[string]interface{}
+	}{
+		{
+			testTokenConf,
+			testTokenInterface,
+		},
+		{
+			testTokenCreatedConf,
+			testTokenCreatedInterface,
+		},
+	}
+
+	for _, tc := range cases {
+		output := schema.TestResourceDataRaw(t, tokenFields(), map[string]interface{}{})
+		err := flattenToken(output, tc.Input)
+		if err != nil {
+			t.Fatalf("[ERROR] on flattener: %#v", err)
+		}
+		expectedOutput := map[string]interface{}{}
+		for k := range tc.ExpectedOutput {
+			expectedOutput[k] = output.Get(k)
+		}
+		if !reflect.DeepEqual(expectedOutput, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expectedOutput)
+		}
+	}
+}
+
+func TestExpandToken(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		ExpectedOutput *managementClient.Token
+	}{
+		{
+			testTokenExpandedInterface,
+			testTokenExpandedConf,
+		},
+	}
+
+	for _, tc := range cases {
+		inputResourceData := schema.TestResourceDataRaw(t, tokenFields(), tc.Input)
+		output := expandToken(inputResourceData)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
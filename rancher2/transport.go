@@ -0,0 +1,312 @@
+package rancher2
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	retryDefaultMax     = 3
+	retryDefaultBackoff = 2 * time.Second
+	retryMaxBackoff     = 30 * time.Second
+	retryDefaultTimeout = time.Minute
+	debugRedactedValue  = "<redacted>"
+)
+
+var (
+	// debugSensitiveFields are the normalized names of the provider schema sensitive fields
+	debugSensitiveFields     map[string]bool
+	debugSensitiveFieldsOnce sync.Once
+)
+
+// retryTransport is a http.RoundTripper retrying transient Rancher API errors. Every try is limited by Timeout
+type retryTransport struct {
+	Transport  http.RoundTripper
+	MaxRetries int
+	Backoff    time.Duration
+	Timeout    time.Duration
+}
+
+// cancelBody is a response body canceling its request context once closed
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// debugTransport is a http.RoundTripper logging Rancher API requests and responses with sensitive fields redacted
+type debugTransport struct {
+	Transport http.RoundTripper
+}
+
+func newHTTPTransport(caCerts string, insecure bool) *http.Transport {
+	transport := &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure},
+	}
+
+	if caCerts != "" {
+		// Get the SystemCertPool, continue with an empty pool on error
+		rootCAs, _ := x509.SystemCertPool()
+		if rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		// Append our cert to the system pool
+		if ok := rootCAs.AppendCertsFromPEM([]byte(caCerts)); !ok {
+			log.Println("No certs appended, using system certs only")
+		}
+		transport.TLSClientConfig.RootCAs = rootCAs
+	}
+
+	return transport
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for retry := 0; ; retry++ {
+		if body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+			req.ContentLength = int64(len(body))
+		}
+
+		resp, err := t.try(req)
+
+		reason := t.retryReason(req, body, resp, err)
+		if reason == "" || retry >= t.MaxRetries {
+			return resp, err
+		}
+
+		if resp != nil {
+			ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		}
+
+		log.Printf("[INFO] Retrying Rancher API %s %s (%d/%d): %s", req.Method, req.URL.String(), retry+1, t.MaxRetries, reason)
+
+		if err := t.wait(req, retry); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// try sends req once, limited by t.Timeout if set
+func (t *retryTransport) try(req *http.Request) (*http.Response, error) {
+	if t.Timeout <= 0 {
+		return t.Transport.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.Timeout)
+	resp, err := t.Transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// maxDuration returns the maximum time spent on a request, including all tries and backoffs
+func (t *retryTransport) maxDuration() time.Duration {
+	total := time.Duration(0)
+	for retry := 0; retry < t.MaxRetries; retry++ {
+		total += t.Timeout + t.backoff(retry)
+	}
+
+	return total + t.Timeout
+}
+
+// retryReason returns why req should be retried, or empty string if it shouldn't.
+// Conflicting updates are only retried if they are partial, without resourceVersion. Rancher applies them to the current object,
+// resending an update with a stale resourceVersion would override concurrent changes or conflict again
+func (t *retryTransport) retryReason(req *http.Request, body []byte, resp *http.Response, err error) string {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		if err != nil {
+			return err.Error()
+		}
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return resp.Status
+		}
+	case http.MethodPut:
+		if err == nil && resp.StatusCode == http.StatusConflict && !hasResourceVersion(body) {
+			return resp.Status
+		}
+	}
+
+	return ""
+}
+
+func (t *retryTransport) backoff(retry int) time.Duration {
+	backoff := t.Backoff << uint(retry)
+	if backoff < 0 || backoff > retryMaxBackoff || (t.Backoff > 0 && backoff < t.Backoff) {
+		backoff = retryMaxBackoff
+	}
+
+	return backoff
+}
+
+func (t *retryTransport) wait(req *http.Request, retry int) error {
+	timer := time.NewTimer(t.backoff(retry))
+	defer timer.Stop()
+
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// hasResourceVersion returns true if body is a JSON object with resourceVersion
+func hasResourceVersion(body []byte) bool {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return false
+	}
+	_, ok := obj["resourceVersion"]
+
+	return ok
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		log.Printf("[DEBUG] Rancher API request %s %s: %s", req.Method, req.URL.String(), redactDebugBody(body))
+	} else {
+		log.Printf("[DEBUG] Rancher API request %s %s", req.Method, req.URL.String())
+	}
+
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil {
+		log.Printf("[DEBUG] Rancher API response %s %s: %v", req.Method, req.URL.String(), err)
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	log.Printf("[DEBUG] Rancher API response %s %s %s: %s", req.Method, req.URL.String(), resp.Status, redactDebugBody(body))
+
+	return resp, nil
+}
+
+// redactDebugBody returns body as string with sensitive fields values redacted. Non JSON bodies aren't logged
+func redactDebugBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var obj interface{}
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return fmt.Sprintf("<%d bytes non JSON body>", len(body))
+	}
+
+	out, err := json.Marshal(redactDebugValue(obj))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes body>", len(body))
+	}
+
+	return string(out)
+}
+
+func redactDebugValue(in interface{}) interface{} {
+	switch v := in.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isDebugSensitiveField(key) {
+				switch sensitive := value.(type) {
+				case []interface{}:
+					// Lists are walked, collections data field is named like secret data
+				case map[string]interface{}:
+					for k, value := range sensitive {
+						if value != nil && value != "" {
+							sensitive[k] = debugRedactedValue
+						}
+					}
+					continue
+				default:
+					if value != nil && value != "" {
+						v[key] = debugRedactedValue
+					}
+					continue
+				}
+			}
+			v[key] = redactDebugValue(value)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactDebugValue(v[i])
+		}
+	}
+
+	return in
+}
+
+// isDebugSensitiveField returns true if the rancher API field name matches a provider schema sensitive field
+func isDebugSensitiveField(name string) bool {
+	debugSensitiveFieldsOnce.Do(func() {
+		debugSensitiveFields = map[string]bool{}
+		provider := Provider().(*schema.Provider)
+		addDebugSensitiveFields(provider.Schema)
+		for _, r := range provider.ResourcesMap {
+			addDebugSensitiveFields(r.Schema)
+		}
+		for _, r := range provider.DataSourcesMap {
+			addDebugSensitiveFields(r.Schema)
+		}
+	})
+
+	return debugSensitiveFields[normalizeDebugFieldName(name)]
+}
+
+func addDebugSensitiveFields(in map[string]*schema.Schema) {
+	for name, s := range in {
+		if s.Sensitive {
+			debugSensitiveFields[normalizeDebugFieldName(name)] = true
+		}
+		if r, ok := s.Elem.(*schema.Resource); ok {
+			addDebugSensitiveFields(r.Schema)
+		}
+	}
+}
+
+// normalizeDebugFieldName allows to match schema snake_case names with rancher API camelCase names
+func normalizeDebugFieldName(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "", -1))
+}
@@ -0,0 +1,191 @@
+package rancher2
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	cases := []struct {
+		Method         string
+		Statuses       []int
+		MaxRetries     int
+		ExpectedStatus int
+		ExpectedCalls  map[string]int
+		Body           string
+	}{
+		{
+			Method:         http.MethodGet,
+			Statuses:       []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			MaxRetries:     3,
+			ExpectedStatus: http.StatusOK,
+			ExpectedCalls:  map[string]int{http.MethodGet: 3},
+		},
+		{
+			Method:         http.MethodGet,
+			Statuses:       []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			MaxRetries:     1,
+			ExpectedStatus: http.StatusBadGateway,
+			ExpectedCalls:  map[string]int{http.MethodGet: 2},
+		},
+		{
+			Method:         http.MethodGet,
+			Statuses:       []int{http.StatusNotFound},
+			MaxRetries:     3,
+			ExpectedStatus: http.StatusNotFound,
+			ExpectedCalls:  map[string]int{http.MethodGet: 1},
+		},
+		{
+			Method:         http.MethodPut,
+			Statuses:       []int{http.StatusConflict, http.StatusOK},
+			MaxRetries:     3,
+			ExpectedStatus: http.StatusOK,
+			ExpectedCalls:  map[string]int{http.MethodPut: 2},
+		},
+		{
+			Method:         http.MethodPut,
+			Body:           `{"resourceVersion":"1"}`,
+			Statuses:       []int{http.StatusConflict, http.StatusOK},
+			MaxRetries:     3,
+			ExpectedStatus: http.StatusConflict,
+			ExpectedCalls:  map[string]int{http.MethodPut: 1},
+		},
+		{
+			Method:         http.MethodPost,
+			Statuses:       []int{http.StatusServiceUnavailable, http.StatusCreated},
+			MaxRetries:     3,
+			ExpectedStatus: http.StatusServiceUnavailable,
+			ExpectedCalls:  map[string]int{http.MethodPost: 1},
+		},
+	}
+
+	for _, tc := range cases {
+		calls := map[string]int{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls[r.Method]++
+			body, _ := ioutil.ReadAll(r.Body)
+			if tc.Method != http.MethodGet && string(body) != tc.Body {
+				t.Errorf("Unexpected request body on %s call %d: %q", r.Method, calls[r.Method], body)
+			}
+			w.WriteHeader(tc.Statuses[calls[r.Method]-1])
+		}))
+
+		client := &http.Client{
+			Transport: &retryTransport{
+				Transport:  http.DefaultTransport,
+				MaxRetries: tc.MaxRetries,
+				Timeout:    retryDefaultTimeout,
+			},
+		}
+
+		var body *bytes.Reader
+		if tc.Method != http.MethodGet {
+			if tc.Body == "" {
+				tc.Body = "{}"
+			}
+			body = bytes.NewReader([]byte(tc.Body))
+		}
+		req, err := http.NewRequest(tc.Method, server.URL, nil)
+		if body != nil {
+			req, err = http.NewRequest(tc.Method, server.URL, body)
+		}
+		if err != nil {
+			t.Fatalf("[ERROR] creating request: %v", err)
+		}
+
+		resp, err := client.Do(req)
+		server.Close()
+		if err != nil {
+			t.Fatalf("[ERROR] %s request: %v", tc.Method, err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != tc.ExpectedStatus {
+			t.Fatalf("Unexpected %s status.\nExpected: %d\nGiven:    %d", tc.Method, tc.ExpectedStatus, resp.StatusCode)
+		}
+		for method, expected := range tc.ExpectedCalls {
+			if calls[method] != expected {
+				t.Fatalf("Unexpected number of %s calls.\nExpected: %d\nGiven:    %d", method, expected, calls[method])
+			}
+		}
+	}
+}
+
+func TestRetryTransportMaxDuration(t *testing.T) {
+	cases := []struct {
+		MaxRetries     int
+		Backoff        time.Duration
+		ExpectedOutput time.Duration
+	}{
+		{
+			MaxRetries:     0,
+			Backoff:        2 * time.Second,
+			ExpectedOutput: time.Minute,
+		},
+		{
+			MaxRetries:     3,
+			Backoff:        2 * time.Second,
+			ExpectedOutput: 4*time.Minute + 14*time.Second,
+		},
+		{
+			MaxRetries:     10,
+			Backoff:        2 * time.Second,
+			ExpectedOutput: 11*time.Minute + 30*time.Second + 6*retryMaxBackoff,
+		},
+	}
+
+	for _, tc := range cases {
+		transport := &retryTransport{
+			MaxRetries: tc.MaxRetries,
+			Backoff:    tc.Backoff,
+			Timeout:    retryDefaultTimeout,
+		}
+		output := transport.maxDuration()
+		if output != tc.ExpectedOutput {
+			t.Fatalf("Unexpected max duration.\nExpected: %s\nGiven:    %s", tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestRedactDebugBody(t *testing.T) {
+	cases := []struct {
+		Input          string
+		ExpectedOutput string
+	}{
+		{
+			Input:          `{"name":"foo","secretKey":"XXXXXXXX","amazonec2credentialConfig":{"accessKey":"YYYYYYYY","secretKey":"ZZZZZZZZ","defaultRegion":"us-east-1"}}`,
+			ExpectedOutput: `{"name":"foo","secretKey":"<redacted>","amazonec2credentialConfig":{"accessKey":"<redacted>","secretKey":"<redacted>","defaultRegion":"us-east-1"}}`,
+		},
+		{
+			Input:          `{"data":[{"token":"kubeconfig-u-xxx:yyy","password":""}]}`,
+			ExpectedOutput: `{"data":[{"token":"<redacted>","password":""}]}`,
+		},
+		{
+			Input:          `{"name":"foo","data":{"username":"dXNlcg==","password":""}}`,
+			ExpectedOutput: `{"name":"foo","data":{"username":"<redacted>","password":""}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		output := redactDebugBody([]byte(tc.Input))
+		var obj, expectedObj interface{}
+		if err := json.Unmarshal([]byte(output), &obj); err != nil {
+			t.Fatalf("[ERROR] Unmarshaling redacted body %q: %v", output, err)
+		}
+		json.Unmarshal([]byte(tc.ExpectedOutput), &expectedObj)
+		if !reflect.DeepEqual(obj, expectedObj) {
+			t.Fatalf("Unexpected output from redactor.\nExpected: %s\nGiven:    %s", tc.ExpectedOutput, output)
+		}
+	}
+
+	output := redactDebugBody([]byte("password=XXXXXXXX"))
+	if output != "<17 bytes non JSON body>" {
+		t.Fatalf("Unexpected output from redactor for non JSON body: %s", output)
+	}
+}
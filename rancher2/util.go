@@ -2,8 +2,11 @@ package rancher2
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -14,6 +17,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/rancher/norman/clientbase"
 	"github.com/rancher/norman/types"
 	"golang.org/x/crypto/bcrypt"
@@ -42,6 +47,23 @@ func HashPasswordString(password string) (string, error) {
 	return string(hash), nil
 }
 
+func Base64Encode(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+func Base64Decode(s string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] problem decoding base64 string: %v", err)
+	}
+	return string(data), nil
+}
+
+func GetSHA256Checksum(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
 func NewListOpts(filters map[string]interface{}) *types.ListOpts {
 	listOpts := clientbase.NewListOpts()
 	if filters != nil {
@@ -51,16 +73,32 @@ func NewListOpts(filters map[string]interface{}) *types.ListOpts {
 	return listOpts
 }
 
-func DoUserLogin(url, user, pass, ttl, desc, cacert string, insecure bool) (string, string, error) {
+type userLoginInput struct {
+	Username    string `json:"username"`
+	Password    string `json:"password"`
+	TTL         int64  `json:"ttl"`
+	Description string `json:"description"`
+}
+
+// DoUserLogin logins local user and returns session token id and token. ttl is in milliseconds
+func DoUserLogin(url, user, pass string, ttl int64, desc, cacert string, insecure bool) (string, string, error) {
 	loginURL := url + "-public/localProviders/local?action=login"
-	loginData := `{"username": "` + user + `", "password": "` + pass + `", "ttl": ` + ttl + `, "description": "` + desc + `"}`
+	loginData, err := json.Marshal(userLoginInput{
+		Username:    user,
+		Password:    pass,
+		TTL:         ttl,
+		Description: desc,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("Marshaling user login data: %v", err)
+	}
 	loginHead := map[string]string{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	}
 
 	// Login with user and pass
-	loginResp, err := DoPost(loginURL, loginData, cacert, insecure, loginHead)
+	loginResp, err := DoPost(loginURL, string(loginData), cacert, insecure, loginHead)
 	if err != nil {
 		return "", "", err
 	}
@@ -144,6 +182,12 @@ func IsNotFound(err error) bool {
 	return clientbase.IsNotFound(err)
 }
 
+// isUnknownValue returns true if value is not known until apply. Diff functions get config.UnknownVariableValue
+// from interpolated values, or the interpolation string itself if they're read from the raw config
+func isUnknownValue(value string) bool {
+	return value == config.UnknownVariableValue || strings.HasPrefix(value, "${")
+}
+
 func splitTokenID(token string) string {
 	separator := ":"
 
@@ -170,6 +214,23 @@ func clusterIDFromProjectID(projectID string) (string, error) {
 	return projectID[0:strings.Index(projectID, clusterProjectIDSeparator)], nil
 }
 
+// setDefaultProjectID sets resource project_id to the config_file default project if it isn't set, and returns it
+func setDefaultProjectID(d *schema.ResourceData, meta interface{}) (string, error) {
+	if v, ok := d.Get("project_id").(string); ok && len(v) > 0 {
+		return v, nil
+	}
+
+	projectID := meta.(*Config).DefaultProjectID
+	if len(projectID) == 0 {
+		return "", fmt.Errorf("[ERROR] project_id should be provided if config_file has no default project")
+	}
+
+	log.Printf("[INFO] Using config_file default project %s", projectID)
+	d.Set("project_id", projectID)
+
+	return projectID, nil
+}
+
 func splitProjectID(id string) (clusterID, projectID string) {
 	id = strings.TrimSuffix(id, clusterProjectIDSeparator)
 
@@ -180,6 +241,25 @@ func splitProjectID(id string) (clusterID, projectID string) {
 	return id, ""
 }
 
+// splitProjectScopedID splits <cluster_id>:<project_id>:<name> and <cluster_id>:<project_id>:<namespace_id>:<name> ids
+func splitProjectScopedID(id string) (projectID, namespaceID, name string, ok bool) {
+	fields := strings.Split(id, clusterProjectIDSeparator)
+	for _, field := range fields {
+		if field == "" {
+			return "", "", "", false
+		}
+	}
+
+	switch len(fields) {
+	case 3:
+		return fields[0] + clusterProjectIDSeparator + fields[1], "", fields[2], true
+	case 4:
+		return fields[0] + clusterProjectIDSeparator + fields[1], fields[2], fields[3], true
+	}
+
+	return "", "", "", false
+}
+
 func toArrayString(in []interface{}) []string {
 	out := make([]string, len(in))
 	for i, v := range in {
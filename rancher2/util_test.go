@@ -0,0 +1,81 @@
+package rancher2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestIsUnknownValue(t *testing.T) {
+
+	cases := []struct {
+		Input          string
+		ExpectedOutput bool
+	}{
+		{
+			config.UnknownVariableValue,
+			true,
+		},
+		{
+			"${rancher2_project.foo.id}",
+			true,
+		},
+		{
+			"c-test:p-test",
+			false,
+		},
+		{
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		output := isUnknownValue(tc.Input)
+		if output != tc.ExpectedOutput {
+			t.Fatalf("Unexpected output from isUnknownValue(%#v).\nExpected: %#v\nGiven:    %#v", tc.Input, tc.ExpectedOutput, output)
+		}
+	}
+}
+
+func TestSetDefaultProjectID(t *testing.T) {
+
+	cases := []struct {
+		Input          map[string]interface{}
+		DefaultProject string
+		ExpectedOutput string
+		ExpectedError  bool
+	}{
+		{
+			map[string]interface{}{"project_id": "c-test:p-test"},
+			"c-default:p-default",
+			"c-test:p-test",
+			false,
+		},
+		{
+			map[string]interface{}{},
+			"c-default:p-default",
+			"c-default:p-default",
+			false,
+		},
+		{
+			map[string]interface{}{},
+			"",
+			"",
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, secretFields(), tc.Input)
+		output, err := setDefaultProjectID(d, &Config{DefaultProjectID: tc.DefaultProject})
+		if (err != nil) != tc.ExpectedError {
+			t.Fatalf("Unexpected error from setDefaultProjectID: %v", err)
+		}
+		if output != tc.ExpectedOutput || d.Get("project_id").(string) != tc.ExpectedOutput {
+			t.Fatalf("Unexpected output from setDefaultProjectID.\nExpected: %#v\nGiven:    %#v, project_id %#v",
+				tc.ExpectedOutput, output, d.Get("project_id"))
+		}
+	}
+}